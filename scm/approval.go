@@ -0,0 +1,85 @@
+package scm
+
+import (
+	"context"
+	"strings"
+)
+
+type (
+	// ApprovalRule represents a rule requiring approvals
+	// before a pull request can be merged.
+	ApprovalRule struct {
+		ID       int
+		Name     string
+		Required int // number of approvals required
+
+		// Approvers are the users eligible to approve. Any
+		// user can approve if the list is empty.
+		Approvers []User
+
+		ApprovedBy []User
+		Approved   bool
+	}
+
+	// ApprovalStatus represents the evaluated approval rules
+	// of a pull request.
+	ApprovalStatus struct {
+		// Approved is true if all the rules are satisfied.
+		Approved   bool
+		ApprovedBy []User
+		Rules      []*ApprovalRule
+		Missing    []*ApprovalRule // rules not yet satisfied
+	}
+
+	// ApprovalService provides access to pull request
+	// approval resources.
+	ApprovalService interface {
+		// ListRules returns the approval rules of a pull request.
+		ListRules(ctx context.Context, repo string, number int) ([]*ApprovalRule, *Response, error)
+
+		// FindStatus returns the evaluated approval rules of a
+		// pull request.
+		FindStatus(ctx context.Context, repo string, number int) (*ApprovalStatus, *Response, error)
+
+		// Approve approves a pull request as the authenticated user.
+		Approve(ctx context.Context, repo string, number int) (*Response, error)
+
+		// Unapprove revokes the approval of the authenticated user.
+		Unapprove(ctx context.Context, repo string, number int) (*Response, error)
+	}
+)
+
+// EvaluateApprovals evaluates the rules against the users who
+// approved the pull request, for providers which do not evaluate
+// the rules themselves. The ApprovedBy and Approved fields of each
+// rule are updated.
+func EvaluateApprovals(rules []*ApprovalRule, approvedBy []User) *ApprovalStatus {
+	status := &ApprovalStatus{
+		Approved:   true,
+		ApprovedBy: approvedBy,
+		Rules:      rules,
+	}
+	for _, rule := range rules {
+		rule.ApprovedBy = nil
+		for _, user := range approvedBy {
+			if len(rule.Approvers) == 0 || containsUser(rule.Approvers, user) {
+				rule.ApprovedBy = append(rule.ApprovedBy, user)
+			}
+		}
+		rule.Approved = len(rule.ApprovedBy) >= rule.Required
+		if !rule.Approved {
+			status.Approved = false
+			status.Missing = append(status.Missing, rule)
+		}
+	}
+	return status
+}
+
+func containsUser(users []User, user User) bool {
+	for _, u := range users {
+		if strings.EqualFold(u.Login, user.Login) {
+			return true
+		}
+	}
+	return false
+}
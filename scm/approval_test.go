@@ -0,0 +1,31 @@
+package scm
+
+import (
+	"testing"
+)
+
+func TestEvaluateApprovals(t *testing.T) {
+	rules := []*ApprovalRule{
+		{Name: "Any", Required: 2},
+		{Name: "Security", Required: 1, Approvers: []User{{Login: "alice"}}},
+		{Name: "Release", Required: 1, Approvers: []User{{Login: "carol"}}},
+	}
+	status := EvaluateApprovals(rules, []User{{Login: "Alice"}, {Login: "bob"}})
+	if status.Approved {
+		t.Errorf("Want approval status not approved")
+	}
+	if got, want := len(rules[0].ApprovedBy), 2; got != want {
+		t.Errorf("Want %d approvals for rule %s, got %d", want, rules[0].Name, got)
+	}
+	if !rules[0].Approved || !rules[1].Approved || rules[2].Approved {
+		t.Errorf("Unexpected rule evaluation")
+	}
+	if len(status.Missing) != 1 || status.Missing[0].Name != "Release" {
+		t.Errorf("Want the Release rule to be missing, got %v", status.Missing)
+	}
+
+	status = EvaluateApprovals(nil, nil)
+	if !status.Approved {
+		t.Errorf("Want approval status approved without rules")
+	}
+}
@@ -54,6 +54,13 @@ type (
 
 		Page Page // Page values
 		Rate Rate // Rate limit snapshot
+
+		// Scopes granted to the token and the scopes accepted
+		// by the endpoint, as reported by the X-OAuth-Scopes and
+		// X-Accepted-OAuth-Scopes headers. Nil if the provider
+		// does not report them.
+		Scopes         []string
+		AcceptedScopes []string
 	}
 
 	// Page represents parsed link rel values for
@@ -107,17 +114,22 @@ type (
 		// Services used for communicating with the API.
 		Driver        Driver
 		Apps          AppService
+		Approvals     ApprovalService
 		Contents      ContentService
 		Deployments   DeploymentService
 		Git           GitService
 		GraphQL       GraphQLService
 		Organizations OrganizationService
 		Issues        IssueService
+		MergeQueues   MergeQueueService
 		Milestones    MilestoneService
 		Releases      ReleaseService
 		PullRequests  PullRequestService
+		Reactions     ReactionService
 		Repositories  RepositoryService
 		Reviews       ReviewService
+		Search        SearchService
+		Server        ServerService
 		Users         UserService
 		Webhooks      WebhookService
 		Commits       CommitService
@@ -129,6 +141,11 @@ type (
 
 		// snapshot of the request rate limit.
 		rate Rate
+
+		// cached server product and version details, or the
+		// definitive error detecting them.
+		serverInfo    *ServerInfo
+		serverInfoErr error
 	}
 )
 
@@ -148,6 +165,55 @@ func (c *Client) SetRate(rate Rate) {
 	c.mu.Unlock()
 }
 
+// ServerInfo returns the product, version and edition of the
+// git server. The result is cached for the lifetime of the client
+// so that drivers can cheaply branch on the server version. Errors
+// are only cached when the server cannot report its version, other
+// errors are retried on the next call.
+func (c *Client) ServerInfo(ctx context.Context) (*ServerInfo, error) {
+	c.mu.Lock()
+	info, err := c.serverInfo, c.serverInfoErr
+	c.mu.Unlock()
+	if info != nil || err != nil {
+		return info, err
+	}
+	if c.Server == nil {
+		return nil, ErrNotSupported
+	}
+	info, res, err := c.Server.Find(ctx)
+	if err != nil {
+		if !serverInfoUnavailable(res, err) {
+			return nil, err
+		}
+		info = nil
+	}
+	c.mu.Lock()
+	c.serverInfo, c.serverInfoErr = info, err
+	c.mu.Unlock()
+	return info, err
+}
+
+// serverInfoUnavailable returns true if the error detecting the
+// server details is a definitive answer, rather than a transient
+// failure such as a timeout or a server error.
+func serverInfoUnavailable(res *Response, err error) bool {
+	if err == ErrNotSupported || err == ErrNotFound {
+		return true
+	}
+	return res != nil && res.Status == http.StatusNotFound
+}
+
+// SetServerInfo sets the cached server details for the current
+// client. This can be used to skip server detection when the
+// server version is already known, or to retry it after an
+// error by passing nil.
+func (c *Client) SetServerInfo(info *ServerInfo) {
+	c.mu.Lock()
+	c.serverInfo = info
+	c.serverInfoErr = nil
+	c.mu.Unlock()
+}
+
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the
 // value pointed to by v, or returned as an error if an
@@ -202,9 +268,45 @@ func newResponse(r *http.Response) *Response {
 		Body:   r.Body,
 	}
 	res.PopulatePageValues()
+	res.Scopes = parseScopes(r.Header, "X-OAuth-Scopes")
+	res.AcceptedScopes = parseScopes(r.Header, "X-Accepted-OAuth-Scopes")
 	return res
 }
 
+// MissingScopes returns the scopes accepted by the endpoint if
+// the token was granted none of them, otherwise nil.
+func (r *Response) MissingScopes() []string {
+	if r.Scopes == nil || len(r.AcceptedScopes) == 0 {
+		return nil
+	}
+	for _, accepted := range r.AcceptedScopes {
+		for _, granted := range r.Scopes {
+			if accepted == granted {
+				return nil
+			}
+		}
+	}
+	return r.AcceptedScopes
+}
+
+// parseScopes parses a comma separated scopes header. It
+// returns nil if the header is missing.
+func parseScopes(header http.Header, key string) []string {
+	values, ok := header[http.CanonicalHeaderKey(key)]
+	if !ok {
+		return nil
+	}
+	scopes := []string{}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
 // PopulatePageValues parses the HTTP Link response headers
 // and populates the various pagination link values in the
 // Response.
@@ -13,6 +13,38 @@ func TestClient(t *testing.T) {
 	t.Skip()
 }
 
+func TestResponseScopes(t *testing.T) {
+	res := newResponse(&http.Response{
+		StatusCode: 403,
+		Header: http.Header{
+			"X-Oauth-Scopes":          {"repo, read:org"},
+			"X-Accepted-Oauth-Scopes": {"admin:org, write:org"},
+		},
+	})
+	if got, want := len(res.Scopes), 2; got != want {
+		t.Errorf("Want %d scopes, got %d", want, got)
+	}
+	if got := res.MissingScopes(); len(got) != 2 || got[0] != "admin:org" || got[1] != "write:org" {
+		t.Errorf("Want missing scopes admin:org, write:org, got %v", got)
+	}
+
+	res.Scopes = append(res.Scopes, "write:org")
+	if got := res.MissingScopes(); got != nil {
+		t.Errorf("Want no missing scopes, got %v", got)
+	}
+
+	res = newResponse(&http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"X-Oauth-Scopes": {""}},
+	})
+	if res.Scopes == nil || len(res.Scopes) != 0 {
+		t.Errorf("Want empty non-nil scopes for a token without scopes, got %v", res.Scopes)
+	}
+	if res.AcceptedScopes != nil {
+		t.Errorf("Want nil accepted scopes without the header")
+	}
+}
+
 func TestResponse(t *testing.T) {
 	res := newResponse(&http.Response{
 		StatusCode: 200,
@@ -0,0 +1,297 @@
+// Package codeowners parses CODEOWNERS files and resolves the
+// owners of the files changed by a pull request.
+//
+// The location of the file and the syntax of its patterns depend
+// on the provider:
+//
+// GitHub patterns follow the gitignore rules and the last matching
+// rule wins.
+//
+// GitLab patterns are matched like File.fnmatch and the rules are
+// grouped in sections. The last matching rule of each section wins
+// and sections can be optional or require several approvals.
+//
+// Gitea patterns are regular expressions matched against the whole
+// path, negated with a leading !, and the owners of all the matching
+// rules are combined.
+package codeowners
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// DefaultSection is the name of the section holding the rules
+// which are not in any section.
+const DefaultSection = "codeowners"
+
+type (
+	// File represents a parsed CODEOWNERS file.
+	File struct {
+		Path     string
+		Driver   scm.Driver
+		Sections []*Section
+	}
+
+	// Section represents a section of a CODEOWNERS file. Only
+	// GitLab supports sections, other providers have a single
+	// default section.
+	Section struct {
+		Name      string
+		Optional  bool
+		Approvals int      // approvals required from the owners
+		Owners    []string // default owners of the rules without owners
+		Rules     []*Rule
+	}
+
+	// Rule represents a pattern and its owners.
+	Rule struct {
+		Line    int
+		Pattern string
+		Owners  []string // users, teams and emails as written
+		Negated bool     // Gitea only, matches paths not matching the pattern
+
+		match func(string) bool
+	}
+
+	// Owners represents the owners of a path, or of the paths
+	// of a pull request, in a section.
+	Owners struct {
+		Section   string
+		Optional  bool
+		Approvals int
+		Owners    []string // users, teams and emails as written
+		Users     []string // user logins, with the teams expanded
+		Paths     []string
+	}
+
+	// Result represents the owners of the files changed by a
+	// pull request, by file and by section.
+	Result struct {
+		Files    map[string][]*Owners
+		Sections []*Owners
+	}
+)
+
+// Locations returns the paths where the provider looks up the
+// CODEOWNERS file, in order.
+func Locations(driver scm.Driver) []string {
+	switch driver {
+	case scm.DriverGitlab:
+		return []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+	case scm.DriverGitea:
+		return []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitea/CODEOWNERS"}
+	default:
+		return []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+	}
+}
+
+// Load finds the CODEOWNERS file of the repository at the given
+// ref and parses it. It returns scm.ErrNotFound if the repository
+// has no CODEOWNERS file.
+func Load(ctx context.Context, client *scm.Client, repo, ref string) (*File, error) {
+	for _, path := range Locations(client.Driver) {
+		content, res, err := client.Contents.Find(ctx, repo, path, ref)
+		if err == scm.ErrNotFound || (res != nil && res.Status == 404) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		file, err := Parse(client.Driver, content.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		file.Path = path
+		return file, nil
+	}
+	return nil, scm.ErrNotFound
+}
+
+var sectionRegexp = regexp.MustCompile(`^(\^)?\[([^\]]+)\](?:\[(\d+)\])?(.*)$`)
+
+// Parse parses the content of a CODEOWNERS file using the syntax
+// of the provider.
+func Parse(driver scm.Driver, data []byte) (*File, error) {
+	file := &File{Driver: driver}
+	section := &Section{Name: DefaultSection, Approvals: 1, Owners: []string{}}
+	file.Sections = append(file.Sections, section)
+	defaults := []string{}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if driver == scm.DriverGitlab {
+			if m := sectionRegexp.FindStringSubmatch(line); m != nil {
+				section = file.section(m[2], m[1] != "", m[3])
+				defaults = tokenize(m[4], true)
+				section.Owners = appendUnique(section.Owners, defaults...)
+				continue
+			}
+		}
+		rule, err := parseRule(driver, line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if rule == nil {
+			continue
+		}
+		if len(rule.Owners) == 0 {
+			rule.Owners = defaults
+		}
+		rule.Line = i + 1
+		section.Rules = append(section.Rules, rule)
+	}
+	return file, nil
+}
+
+// section returns the section with the given name, adding it if
+// needed. Sections with the same name are combined, ignoring case,
+// and the approvals are only updated when they are given.
+func (f *File) section(name string, optional bool, approvals string) *Section {
+	var section *Section
+	for _, v := range f.Sections {
+		if strings.EqualFold(v.Name, name) {
+			section = v
+			break
+		}
+	}
+	if section == nil {
+		section = &Section{Name: name, Approvals: 1, Owners: []string{}}
+		f.Sections = append(f.Sections, section)
+	} else if approvals == "" && !optional {
+		return section
+	}
+	section.Optional = optional
+	if approvals != "" {
+		section.Approvals, _ = strconv.Atoi(approvals)
+	}
+	if optional {
+		section.Approvals = 0
+	}
+	return section
+}
+
+func parseRule(driver scm.Driver, line string) (*Rule, error) {
+	tokens := tokenize(line, driver != scm.DriverGitea)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	rule := &Rule{
+		Pattern: tokens[0],
+		Owners:  tokens[1:],
+	}
+	var err error
+	switch driver {
+	case scm.DriverGitlab:
+		rule.match, err = gitlabMatcher(rule.Pattern)
+	case scm.DriverGitea:
+		if strings.HasPrefix(rule.Pattern, "!") {
+			rule.Pattern = rule.Pattern[1:]
+			rule.Negated = true
+		}
+		rule.match, err = giteaMatcher(rule.Pattern)
+	default:
+		rule.match, err = githubMatcher(rule.Pattern)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// tokenize splits the line on whitespace, unescaping the escaped
+// whitespace and number signs. The rest of the line is ignored
+// from the first unescaped number sign if comments is true.
+func tokenize(line string, comments bool) []string {
+	tokens := []string{}
+	token := strings.Builder{}
+	escaped := false
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+	for _, r := range line {
+		switch {
+		case escaped:
+			if r != ' ' && r != '\t' && r != '#' {
+				token.WriteRune('\\')
+			}
+			token.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '#' && comments && token.Len() == 0:
+			return tokens
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if escaped {
+		token.WriteRune('\\')
+	}
+	flush()
+	return tokens
+}
+
+// Match returns the owners of the path in each section with a
+// matching rule.
+func (f *File) Match(path string) []*Owners {
+	path = strings.TrimPrefix(path, "/")
+	owners := []*Owners{}
+	for _, section := range f.Sections {
+		var matched []*Rule
+		for _, rule := range section.Rules {
+			if rule.match(path) == rule.Negated {
+				continue
+			}
+			if f.Driver == scm.DriverGitea {
+				matched = append(matched, rule)
+			} else {
+				matched = []*Rule{rule}
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		out := &Owners{
+			Section:   section.Name,
+			Optional:  section.Optional,
+			Approvals: section.Approvals,
+			Owners:    []string{},
+			Paths:     []string{path},
+		}
+		for _, rule := range matched {
+			out.Owners = appendUnique(out.Owners, rule.Owners...)
+		}
+		owners = append(owners, out)
+	}
+	return owners
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, item := range items {
+		found := false
+		for _, v := range list {
+			if strings.EqualFold(v, item) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,209 @@
+package codeowners
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+)
+
+func TestLocations(t *testing.T) {
+	if got, want := Locations(scm.DriverGithub)[0], ".github/CODEOWNERS"; got != want {
+		t.Errorf("Want GitHub location %s, got %s", want, got)
+	}
+	if got, want := Locations(scm.DriverGitlab)[2], ".gitlab/CODEOWNERS"; got != want {
+		t.Errorf("Want GitLab location %s, got %s", want, got)
+	}
+	if got, want := Locations(scm.DriverGitea)[2], ".gitea/CODEOWNERS"; got != want {
+		t.Errorf("Want Gitea location %s, got %s", want, got)
+	}
+}
+
+func TestParse_Gitlab(t *testing.T) {
+	data := []byte(`
+* @admin
+
+[Documentation][2] @doc-writer @docs/team
+/docs/
+README.md @readme-owner
+
+^[Frontend]
+*.js @frontend-dev # inline comment
+\#file_with_pound.rb @owner-file-with-pound
+path\ with\ spaces/ @space-owner
+
+[documentation]
+/guides/
+`)
+	file, err := Parse(scm.DriverGitlab, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(file.Sections), 3; got != want {
+		t.Fatalf("Want %d sections, got %d", want, got)
+	}
+	docs := file.Sections[1]
+	if docs.Name != "Documentation" || docs.Approvals != 2 || docs.Optional || len(docs.Rules) != 3 {
+		t.Errorf("Unexpected documentation section %+v", docs)
+	}
+	frontend := file.Sections[2]
+	if frontend.Name != "Frontend" || frontend.Approvals != 0 || !frontend.Optional {
+		t.Errorf("Unexpected frontend section %+v", frontend)
+	}
+	if diff := cmp.Diff([]string{"@frontend-dev"}, frontend.Rules[0].Owners); diff != "" {
+		t.Errorf("Unexpected owners")
+		t.Log(diff)
+	}
+	if got, want := frontend.Rules[1].Pattern, "#file_with_pound.rb"; got != want {
+		t.Errorf("Want pattern %q, got %q", want, got)
+	}
+	if got, want := frontend.Rules[2].Pattern, "path with spaces/"; got != want {
+		t.Errorf("Want pattern %q, got %q", want, got)
+	}
+
+	got := file.Match("docs/index.md")
+	want := []*Owners{
+		{Section: DefaultSection, Approvals: 1, Owners: []string{"@admin"}, Paths: []string{"docs/index.md"}},
+		{Section: "Documentation", Approvals: 2, Owners: []string{"@doc-writer", "@docs/team"}, Paths: []string{"docs/index.md"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected owners")
+		t.Log(diff)
+	}
+
+	got = file.Match("docs/README.md")
+	if diff := cmp.Diff([]string{"@readme-owner"}, got[1].Owners); diff != "" {
+		t.Errorf("Unexpected owners, the last matching rule must win")
+		t.Log(diff)
+	}
+}
+
+func TestParse_Github(t *testing.T) {
+	data := []byte(`
+*       @global-owner
+*.go    @gopher
+/vendor/
+`)
+	file, err := Parse(scm.DriverGithub, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"@gopher"}, file.Match("scm/client.go")[0].Owners); diff != "" {
+		t.Errorf("Unexpected owners, the last matching rule must win")
+		t.Log(diff)
+	}
+	if got := file.Match("vendor/lib/lib.go")[0].Owners; len(got) != 0 {
+		t.Errorf("Want no owners, got %v", got)
+	}
+	if _, err := Parse(scm.DriverGithub, []byte("[abc].go @owner")); err == nil {
+		t.Errorf("Want an error for unsupported patterns")
+	}
+}
+
+func TestParse_Gitea(t *testing.T) {
+	data := []byte(`
+.*\.go @gopher
+docs/.* @doc-writer @myorg/docs
+!.*\.go @not-gopher
+`)
+	file, err := Parse(scm.DriverGitea, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"@gopher"}, file.Match("scm/client.go")[0].Owners); diff != "" {
+		t.Errorf("Unexpected owners")
+		t.Log(diff)
+	}
+	if diff := cmp.Diff([]string{"@doc-writer", "@myorg/docs", "@not-gopher"}, file.Match("docs/README.md")[0].Owners); diff != "" {
+		t.Errorf("Unexpected owners, all the matching rules must be combined")
+		t.Log(diff)
+	}
+	if _, err := Parse(scm.DriverGitea, []byte("docs/( @owner")); err == nil {
+		t.Errorf("Want an error for invalid regular expressions")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	client, data := fake.NewDefault()
+	data.ContentDir = "testdata"
+
+	file, err := Load(context.Background(), client, "myorg/myrepo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := file.Path, ".github/CODEOWNERS"; got != want {
+		t.Errorf("Want path %s, got %s", want, got)
+	}
+	if got, want := len(file.Sections[0].Rules), 4; got != want {
+		t.Errorf("Want %d rules, got %d", want, got)
+	}
+
+	_, err = Load(context.Background(), client, "myorg/missing", "")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	client, data := fake.NewDefault()
+	data.ContentDir = "testdata"
+
+	file, err := Load(context.Background(), client, "myorg/myrepo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := []*scm.Change{
+		{Path: "README.md"},
+		{Path: "docs/guide.md"},
+		{Path: "scm/client.go"},
+		{Path: "scm/util.go"},
+		{Path: "scm/driver.go", PreviousPath: "docs/driver.go", Renamed: true},
+	}
+	got, err := Resolve(context.Background(), client, file, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantFiles := map[string][]string{
+		"README.md":      {"octocat"},
+		"docs/guide.md":  {"doc-writer"},
+		"scm/client.go":  {"gopher", "sig-lead"},
+		"scm/util.go":    {},
+		"docs/driver.go": {"gopher", "sig-lead"},
+		"scm/driver.go":  {"gopher", "sig-lead"},
+	}
+	if got, want := len(got.Files), len(wantFiles); got != want {
+		t.Errorf("Want %d files, got %d", want, got)
+	}
+	for path, want := range wantFiles {
+		owners := got.Files[path]
+		if len(owners) != 1 {
+			t.Errorf("Want one section for %s, got %d", path, len(owners))
+			continue
+		}
+		if diff := cmp.Diff(want, owners[0].Users); diff != "" {
+			t.Errorf("Unexpected users for %s", path)
+			t.Log(diff)
+		}
+	}
+
+	want := []*Owners{
+		{
+			Section:   DefaultSection,
+			Approvals: 1,
+			Owners:    []string{"@octocat", "@doc-writer", "docs@example.com", "@gopher", "@myorg/leads"},
+			Users:     []string{"octocat", "doc-writer", "gopher", "sig-lead"},
+			Paths:     []string{"README.md", "docs/guide.md", "scm/client.go", "scm/util.go", "docs/driver.go", "scm/driver.go"},
+		},
+	}
+	if diff := cmp.Diff(want, got.Sections, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Unexpected sections")
+		t.Log(diff)
+	}
+}
@@ -0,0 +1,155 @@
+package codeowners
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// githubMatcher returns a matcher following the gitignore rules
+// supported by GitHub. A pattern without a slash, other than a
+// trailing one, matches at any depth, and a pattern matching a
+// directory matches all the files below it, unless its last
+// segment is a single *.
+func githubMatcher(pattern string) (func(string) bool, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, errors.New("negated patterns are not supported")
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, errors.New("character ranges are not supported")
+	}
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	if pattern == "" {
+		return nil, errors.New("empty pattern")
+	}
+
+	expr := strings.Builder{}
+	if anchored {
+		expr.WriteString(`\A`)
+	} else {
+		expr.WriteString(`(?:\A|.*/)`)
+	}
+	segments := strings.Split(pattern, "/")
+	last := segments[len(segments)-1]
+	expr.WriteString(translateSegments(segments, false))
+	switch {
+	case last == "**":
+	case dirOnly:
+		expr.WriteString(`/`)
+	case last == "*" && len(segments) > 1:
+		expr.WriteString(`\z`)
+	default:
+		expr.WriteString(`(?:\z|/)`)
+	}
+	return compile(expr.String())
+}
+
+// gitlabMatcher returns a matcher following the File.fnmatch rules
+// used by GitLab. A pattern without a leading slash matches at any
+// depth, a trailing slash matches all the files below a directory,
+// and * never matches a slash.
+func gitlabMatcher(pattern string) (func(string) bool, error) {
+	if pattern == "*" {
+		pattern = "/**/*"
+	}
+	if !strings.HasPrefix(pattern, "/") {
+		pattern = "/**/" + pattern
+	}
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**/*"
+	}
+	segments := strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+	return compile(`\A` + translateSegments(segments, true) + `\z`)
+}
+
+// giteaMatcher returns a matcher for the regular expressions used
+// by Gitea, which must match the whole path.
+func giteaMatcher(pattern string) (func(string) bool, error) {
+	return compile(`\A(?:` + pattern + `)\z`)
+}
+
+func compile(expr string) (func(string) bool, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
+
+// translateSegments translates the glob path segments to a regular
+// expression. The File.fnmatch semantics, with character classes,
+// braces and a trailing ** matching a single segment, are used if
+// fnmatch is true.
+func translateSegments(segments []string, fnmatch bool) string {
+	expr := strings.Builder{}
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if segment == "**" {
+			if last && fnmatch {
+				expr.WriteString(`[^/]*`)
+			} else if last {
+				expr.WriteString(`.*`)
+			} else {
+				expr.WriteString(`(?:.*/)?`)
+			}
+			continue
+		}
+		expr.WriteString(translateGlob(segment, fnmatch))
+		if !last {
+			expr.WriteString(`/`)
+		}
+	}
+	return expr.String()
+}
+
+func translateGlob(glob string, fnmatch bool) string {
+	expr := strings.Builder{}
+	braces := 0
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case c == '*':
+			for i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+			}
+			expr.WriteString(`[^/]*`)
+		case c == '?':
+			expr.WriteString(`[^/]`)
+		case c == '\\' && i+1 < len(glob):
+			i++
+			expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case c == '[' && fnmatch:
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			i += end + 1
+			negated := strings.HasPrefix(class, "!") || strings.HasPrefix(class, "^")
+			if negated {
+				class = class[1:]
+			}
+			class = strings.ReplaceAll(class, `\`, `\\`)
+			if negated {
+				expr.WriteString(`[^/` + class + `]`)
+			} else {
+				expr.WriteString(`[` + class + `]`)
+			}
+		case c == '{' && fnmatch:
+			braces++
+			expr.WriteString(`(?:`)
+		case c == ',' && fnmatch && braces > 0:
+			expr.WriteString(`|`)
+		case c == '}' && fnmatch && braces > 0:
+			braces--
+			expr.WriteString(`)`)
+		default:
+			expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	return expr.String()
+}
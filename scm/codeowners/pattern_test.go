@@ -0,0 +1,118 @@
+package codeowners
+
+import (
+	"testing"
+)
+
+func TestGithubMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "README.md", true},
+		{"*", "docs/README.md", true},
+		{"*.js", "app.js", true},
+		{"*.js", "src/app.js", true},
+		{"*.js", "src/app.jsx", false},
+		{"/build/logs/", "build/logs/app.log", true},
+		{"/build/logs/", "build/logs/2020/app.log", true},
+		{"/build/logs/", "src/build/logs/app.log", false},
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		{"apps/", "apps/main.go", true},
+		{"apps/", "src/apps/main.go", true},
+		{"apps/", "apps", false},
+		{"/apps/github", "apps/github/main.go", true},
+		{"/apps/github", "apps/github", true},
+		{"/apps/github", "apps/githubx", false},
+		{"**/logs", "logs/app.log", true},
+		{"**/logs", "build/logs/app.log", true},
+		{"/docs/**", "docs/a/b/c.md", true},
+		{"docs/**/*.md", "docs/index.md", true},
+		{"docs/**/*.md", "docs/a/b/index.md", true},
+		{"docs/**/*.md", "docs/a/b/index.txt", false},
+		{"README.?d", "README.md", true},
+	}
+	for _, test := range tests {
+		match, err := githubMatcher(test.pattern)
+		if err != nil {
+			t.Errorf("pattern %q: %s", test.pattern, err)
+			continue
+		}
+		if got := match(test.path); got != test.want {
+			t.Errorf("pattern %q path %q: want match %v, got %v", test.pattern, test.path, test.want, got)
+		}
+	}
+}
+
+func TestGithubMatcher_Invalid(t *testing.T) {
+	for _, pattern := range []string{"!*.go", "[abc].go", "/"} {
+		if _, err := githubMatcher(pattern); err == nil {
+			t.Errorf("pattern %q: want error", pattern)
+		}
+	}
+}
+
+func TestGitlabMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "README.md", true},
+		{"*", "docs/README.md", true},
+		{"*", ".gitignore", true},
+		{"README.md", "README.md", true},
+		{"README.md", "docs/README.md", true},
+		{"/README.md", "docs/README.md", false},
+		{"internal/README.md", "internal/README.md", true},
+		{"internal/README.md", "app/internal/README.md", true},
+		{"/docs/", "docs/a/b/c.md", true},
+		{"/docs", "docs/index.md", false},
+		{"/docs/*", "docs/index.md", true},
+		{"/docs/*", "docs/a/index.md", false},
+		{"/docs/**/*.md", "docs/index.md", true},
+		{"/docs/**/*.md", "docs/a/b/index.md", true},
+		{"*.{rb,js}", "app/main.js", true},
+		{"*.{rb,js}", "app/main.go", false},
+		{"/lib/[abc].rb", "lib/b.rb", true},
+		{"/lib/[abc].rb", "lib/d.rb", false},
+		{"/lib/[!abc].rb", "lib/d.rb", true},
+	}
+	for _, test := range tests {
+		match, err := gitlabMatcher(test.pattern)
+		if err != nil {
+			t.Errorf("pattern %q: %s", test.pattern, err)
+			continue
+		}
+		if got := match(test.path); got != test.want {
+			t.Errorf("pattern %q path %q: want match %v, got %v", test.pattern, test.path, test.want, got)
+		}
+	}
+}
+
+func TestGiteaMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{".*", "docs/README.md", true},
+		{`.*\.go`, "scm/client.go", true},
+		{`.*\.go`, "scm/client.go.orig", false},
+		{"docs/.*", "docs/README.md", true},
+		{"docs/.*", "src/docs/README.md", false},
+		{"a|b", "ab", false},
+	}
+	for _, test := range tests {
+		match, err := giteaMatcher(test.pattern)
+		if err != nil {
+			t.Errorf("pattern %q: %s", test.pattern, err)
+			continue
+		}
+		if got := match(test.path); got != test.want {
+			t.Errorf("pattern %q path %q: want match %v, got %v", test.pattern, test.path, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package codeowners
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// Resolve returns the owners of the files changed by a pull request,
+// as returned by PullRequestService.ListChanges, by file and by
+// section. Both the source and the destination of a renamed file
+// are owned.
+//
+// The teams are expanded to their members using the organization
+// service. GitLab groups are expanded to their members, but top
+// level groups cannot be told apart from users and are kept as
+// users. Emails and teams which cannot be expanded are only listed
+// in the Owners.
+func Resolve(ctx context.Context, client *scm.Client, file *File, changes []*scm.Change) (*Result, error) {
+	result := &Result{
+		Files:    map[string][]*Owners{},
+		Sections: []*Owners{},
+	}
+	sections := map[string]*Owners{}
+	expander := &expander{client: client, teams: map[string][]string{}}
+
+	for _, path := range changedPaths(changes) {
+		owners := file.Match(path)
+		for _, v := range owners {
+			users, err := expander.expand(ctx, v.Owners)
+			if err != nil {
+				return nil, err
+			}
+			v.Users = users
+
+			section, ok := sections[v.Section]
+			if !ok {
+				section = &Owners{
+					Section:   v.Section,
+					Optional:  v.Optional,
+					Approvals: v.Approvals,
+					Owners:    []string{},
+					Users:     []string{},
+					Paths:     []string{},
+				}
+				sections[v.Section] = section
+			}
+			section.Owners = appendUnique(section.Owners, v.Owners...)
+			section.Users = appendUnique(section.Users, v.Users...)
+			section.Paths = append(section.Paths, path)
+		}
+		result.Files[path] = owners
+	}
+	for _, section := range file.Sections {
+		if v, ok := sections[section.Name]; ok {
+			result.Sections = append(result.Sections, v)
+		}
+	}
+	return result, nil
+}
+
+func changedPaths(changes []*scm.Change) []string {
+	paths := []string{}
+	seen := map[string]bool{}
+	for _, change := range changes {
+		for _, path := range []string{change.PreviousPath, change.Path} {
+			if path != "" && !seen[path] {
+				seen[path] = true
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// expander expands the owners to user logins, caching the members
+// of the teams.
+type expander struct {
+	client *scm.Client
+	teams  map[string][]string
+}
+
+func (e *expander) expand(ctx context.Context, owners []string) ([]string, error) {
+	users := []string{}
+	for _, owner := range owners {
+		switch {
+		case !strings.HasPrefix(owner, "@"), strings.HasPrefix(owner, "@@"):
+			// emails and GitLab roles cannot be expanded.
+		case strings.Contains(owner, "/"):
+			members, err := e.members(ctx, owner[1:])
+			if err != nil {
+				return nil, err
+			}
+			users = appendUnique(users, members...)
+		default:
+			users = appendUnique(users, owner[1:])
+		}
+	}
+	return users, nil
+}
+
+func (e *expander) members(ctx context.Context, team string) ([]string, error) {
+	if members, ok := e.teams[strings.ToLower(team)]; ok {
+		return members, nil
+	}
+	var members []*scm.TeamMember
+	var err error
+	if e.client.Driver == scm.DriverGitlab {
+		members, err = e.listGroupMembers(ctx, team)
+	} else {
+		members, err = e.listTeamMembers(ctx, team)
+	}
+	if err == scm.ErrNotSupported {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	logins := []string{}
+	for _, member := range members {
+		logins = append(logins, member.Login)
+	}
+	e.teams[strings.ToLower(team)] = logins
+	return logins, nil
+}
+
+func (e *expander) listGroupMembers(ctx context.Context, group string) ([]*scm.TeamMember, error) {
+	members := []*scm.TeamMember{}
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out, res, err := e.client.Organizations.ListOrgMembers(ctx, group, opts)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, out...)
+		if res == nil || res.Page.Next == 0 {
+			return members, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func (e *expander) listTeamMembers(ctx context.Context, name string) ([]*scm.TeamMember, error) {
+	org, slug := scm.Split(name)
+	team, err := e.findTeam(ctx, org, slug)
+	if team == nil || err != nil {
+		return nil, err
+	}
+	members := []*scm.TeamMember{}
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out, res, err := e.client.Organizations.ListTeamMembers(ctx, team.ID, "all", opts)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, out...)
+		if res == nil || res.Page.Next == 0 {
+			return members, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// findTeam returns the team of the organization with the given
+// slug, or name if the provider has no slugs.
+func (e *expander) findTeam(ctx context.Context, org, slug string) (*scm.Team, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		teams, res, err := e.client.Organizations.ListTeams(ctx, org, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range teams {
+			if strings.EqualFold(team.Slug, slug) || (team.Slug == "" && strings.EqualFold(team.Name, slug)) {
+				return team, nil
+			}
+		}
+		if res == nil || res.Page.Next == 0 {
+			return nil, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
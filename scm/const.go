@@ -113,6 +113,10 @@ const (
 
 	// check run / check suite
 	ActionCompleted
+
+	// merge group
+	ActionChecksRequested
+	ActionDestroyed
 )
 
 // String returns the string representation of Action.
@@ -158,6 +162,10 @@ func (a Action) String() (s string) {
 		return "converted_to_draft"
 	case ActionCompleted:
 		return "completed"
+	case ActionChecksRequested:
+		return "checks_requested"
+	case ActionDestroyed:
+		return "destroyed"
 	default:
 		return
 	}
@@ -207,6 +215,10 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionDismissed
 	case "edited":
 		*a = ActionEdited
+	case "checks_requested":
+		*a = ActionChecksRequested
+	case "destroyed":
+		*a = ActionDestroyed
 	}
 	return nil
 }
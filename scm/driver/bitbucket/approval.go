@@ -0,0 +1,120 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+// ListRules returns the minimum approvals branch restrictions
+// matching the destination branch of the pull request.
+//
+// See https://developer.atlassian.com/cloud/bitbucket/rest/api-group-branch-restrictions/
+func (s *approvalService) ListRules(ctx context.Context, repo string, number int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	pr, res, err := s.findPullRequest(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.listRules(ctx, repo, pr)
+}
+
+// FindStatus evaluates the branch restrictions against the
+// participants who approved the pull request.
+func (s *approvalService) FindStatus(ctx context.Context, repo string, number int) (*scm.ApprovalStatus, *scm.Response, error) {
+	pr, res, err := s.findPullRequest(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	rules, res, err := s.listRules(ctx, repo, pr)
+	if err != nil {
+		return nil, res, err
+	}
+	approvedBy := []scm.User{}
+	for _, participant := range pr.Participants {
+		if participant.Approved {
+			approvedBy = append(approvedBy, *convertUser(&participant.User))
+		}
+	}
+	return scm.EvaluateApprovals(rules, approvedBy), res, nil
+}
+
+func (s *approvalService) Approve(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/approve", repo, number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *approvalService) Unapprove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/approve", repo, number)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *approvalService) findPullRequest(ctx context.Context, repo string, number int) (*prApprovals, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(prApprovals)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+func (s *approvalService) listRules(ctx context.Context, repo string, pr *prApprovals) ([]*scm.ApprovalRule, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/branch-restrictions?kind=require_approvals_to_merge", repo)
+	out := new(branchRestrictions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	rules := []*scm.ApprovalRule{}
+	for _, v := range out.Values {
+		if v.matches(pr.Destination.Branch.Name) {
+			rules = append(rules, convertBranchRestriction(v))
+		}
+	}
+	return rules, res, nil
+}
+
+type prApprovals struct {
+	Destination  prDestination   `json:"destination"`
+	Participants []prParticipant `json:"participants"`
+}
+
+type branchRestrictions struct {
+	pagination
+	Values []*branchRestriction `json:"values"`
+}
+
+type branchRestriction struct {
+	ID              int    `json:"id"`
+	Kind            string `json:"kind"`
+	BranchMatchKind string `json:"branch_match_kind"`
+	BranchType      string `json:"branch_type"`
+	Pattern         string `json:"pattern"`
+	Value           int    `json:"value"`
+}
+
+// matches returns true if the restriction applies to the branch.
+// Branching model restrictions cannot be evaluated and always
+// match, so that the restriction is not ignored.
+func (r *branchRestriction) matches(branch string) bool {
+	if r.BranchMatchKind != "glob" {
+		return true
+	}
+	ok, _ := path.Match(r.Pattern, branch)
+	return ok
+}
+
+func convertBranchRestriction(from *branchRestriction) *scm.ApprovalRule {
+	pattern := from.Pattern
+	if pattern == "" {
+		pattern = from.BranchType
+	}
+	return &scm.ApprovalRule{
+		ID:       from.ID,
+		Name:     fmt.Sprintf("Required approvals on %s", pattern),
+		Required: from.Value,
+	}
+}
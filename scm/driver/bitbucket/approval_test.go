@@ -0,0 +1,95 @@
+package bitbucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestApprovalListRules(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("kind", "require_approvals_to_merge").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Approvals.ListRules(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, 11, got[0].ID)
+	assert.Equal(t, "Required approvals on master", got[0].Name)
+	assert.Equal(t, 2, got[0].Required)
+}
+
+func TestApprovalFindStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_participants.json")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/branch-restrictions").
+		MatchParam("kind", "require_approvals_to_merge").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_restrictions.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Approvals.FindStatus(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, got.Approved)
+	assert.Len(t, got.ApprovedBy, 1)
+	assert.Equal(t, "jane", got.ApprovedBy[0].Login)
+	assert.Len(t, got.Missing, 1)
+	assert.Equal(t, 11, got.Missing[0].ID)
+}
+
+func TestApprovalApprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/pullrequests/1/approve").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Approvals.Approve(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApprovalUnapprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/pullrequests/1/approve").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Approvals.Unapprove(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -5,8 +5,14 @@
 package bitbucket
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
 	"strings"
 	"time"
 
@@ -69,6 +75,10 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -80,6 +90,20 @@ func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Re
 	return convertBranch(out), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/branches", repo)
+	in := new(branchInput)
+	in.Name = input.Name
+	in.Target.Hash = input.Ref
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertBranch(out), res, err
+}
+
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/commit/%s", repo, ref)
 	out := new(commit)
@@ -94,6 +118,15 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+// FindTagObject finds a tag by name. The api does not return the
+// sha of the tag object.
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, name)
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertAnnotatedTag(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/refs/branches?%s", repo, encodeListOptions(opts))
 	out := new(branches)
@@ -106,6 +139,10 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	// the api can only filter commits by path.
+	if opts.Author != "" || !opts.Since.IsZero() || !opts.Until.IsZero() || opts.FirstParent {
+		return nil, nil, scm.ErrNotSupported
+	}
 	path := fmt.Sprintf("2.0/repositories/%s/commits/%s?%s", repo, opts.Ref, encodeCommitListOptions(opts))
 	out := new(commits)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
@@ -149,8 +186,146 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CommitFiles atomically commits the changes of many files with a
+// multipart upload to the src endpoint. The current head of the branch
+// is passed as the parent, so the commit fails if the branch was
+// updated in the meantime.
+//
+// See https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	head, res, err := s.FindBranch(ctx, repo, input.Branch)
+	if err != nil && res != nil && res.Status == 404 && input.BaseBranch != "" {
+		head, res, err = s.FindBranch(ctx, repo, input.BaseBranch)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	w.WriteField("message", input.Message)
+	w.WriteField("branch", input.Branch)
+	w.WriteField("parents", head.Sha)
+	if input.Author != nil {
+		w.WriteField("author", fmt.Sprintf("%s <%s>", input.Author.Name, input.Author.Email))
+	}
+	for _, file := range input.Files {
+		switch file.Action {
+		case scm.FileActionDelete:
+			w.WriteField("files", file.Path)
+			continue
+		case scm.FileActionMove:
+			w.WriteField("files", file.PreviousPath)
+		}
+		content := file.Content
+		if file.Action == scm.FileActionMove && content == nil {
+			endpoint := fmt.Sprintf("2.0/repositories/%s/src/%s/%s", repo, head.Sha, file.PreviousPath)
+			old := new(bytes.Buffer)
+			if res, err := s.client.do(ctx, "GET", endpoint, nil, old); err != nil {
+				return nil, res, err
+			}
+			content = old.Bytes()
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, file.Path, path.Base(file.Path)))
+		header.Set("Content-Type", "application/octet-stream")
+		if file.Executable {
+			header.Set("X-Attributes", "executable")
+		}
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		part.Write(content)
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req := &scm.Request{
+		Method: "POST",
+		Path:   fmt.Sprintf("2.0/repositories/%s/src", repo),
+		Header: map[string][]string{
+			"Content-Type": {w.FormDataContentType()},
+		},
+		Body: buf,
+	}
+	res, err = s.client.Client.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	res.Body.Close()
+	if res.Status > 300 {
+		return nil, res, errors.New(http.StatusText(res.Status))
+	}
+	// the new commit is only returned in the location header.
+	location := res.Header.Get("Location")
+	sha := location[strings.LastIndex(location, "/")+1:]
+	if sha == "" {
+		return nil, res, errors.New("the commit was not returned in the Location header")
+	}
+	return s.FindCommit(ctx, repo, sha)
+}
+
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type branch struct {
 	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target struct {
+		Hash string    `json:"hash"`
+		Date time.Time `json:"date"`
+	} `json:"target"`
+}
+
+type tag struct {
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+	Date    time.Time `json:"date"`
+	Tagger  struct {
+		Raw  string `json:"raw"`
+		User struct {
+			Username    string `json:"username"`
+			DisplayName string `json:"display_name"`
+			Links       struct {
+				Avatar struct {
+					Href string `json:"href"`
+				} `json:"avatar"`
+			} `json:"links"`
+		} `json:"user"`
+	} `json:"tagger"`
+	Target struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type branchInput struct {
 	Name   string `json:"name"`
 	Target struct {
 		Hash string `json:"hash"`
@@ -251,6 +426,9 @@ type commit struct {
 	Date    time.Time `json:"date"`
 	Message string    `json:"message"`
 	Type    string    `json:"type"`
+	Parents []struct {
+		Hash string `json:"hash"`
+	} `json:"parents"`
 }
 
 func convertDiffstats(from *diffstats) []*scm.Change {
@@ -278,8 +456,28 @@ func convertCommitList(from *commits) []*scm.Commit {
 	return to
 }
 
+func convertAnnotatedTag(from *tag) *scm.Tag {
+	to := &scm.Tag{
+		Name:       from.Name,
+		Message:    from.Message,
+		Target:     from.Target.Hash,
+		TargetType: "commit",
+	}
+	// a lightweight tag has no tagger.
+	if from.Tagger.Raw != "" {
+		to.Tagger = scm.Signature{
+			Name:   from.Tagger.User.DisplayName,
+			Email:  extractEmail(from.Tagger.Raw),
+			Date:   from.Date,
+			Login:  from.Tagger.User.Username,
+			Avatar: from.Tagger.User.Links.Avatar.Href,
+		}
+	}
+	return to
+}
+
 func convertCommit(from *commit) *scm.Commit {
-	return &scm.Commit{
+	to := &scm.Commit{
 		Message: from.Message,
 		Sha:     from.Hash,
 		Link:    from.Links.HTML.Href,
@@ -298,6 +496,10 @@ func convertCommit(from *commit) *scm.Commit {
 			Avatar: from.Author.User.Links.Avatar.Href,
 		},
 	}
+	for _, parent := range from.Parents {
+		to.Parents = append(to.Parents, parent.Hash)
+	}
+	return to
 }
 
 func convertBranchList(from *branches) []*scm.Reference {
@@ -313,6 +515,7 @@ func convertBranch(from *branch) *scm.Reference {
 		Name: scm.TrimRef(from.Name),
 		Path: scm.ExpandRef(from.Name, "refs/heads/"),
 		Sha:  from.Target.Hash,
+		Date: from.Target.Date,
 	}
 }
 
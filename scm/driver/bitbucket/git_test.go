@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -66,6 +67,37 @@ func TestGitFindBranch(t *testing.T) {
 	}
 }
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/branches").
+		JSON(map[string]interface{}{
+			"name":   "master",
+			"target": map[string]string{"hash": "develop"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.BranchInput{Name: "master", Ref: "develop"}
+	got, _, err := client.Git.CreateBranch(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitFindTag(t *testing.T) {
 	defer gock.Off()
 
@@ -91,6 +123,31 @@ func TestGitFindTag(t *testing.T) {
 	}
 }
 
+func TestGitFindTagObject(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/refs/tags/@atlaskit/activity@1.0.3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Git.FindTagObject(context.Background(), "atlassian/atlaskit", "@atlaskit/activity@1.0.3")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_object.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -120,6 +177,14 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListCommitsAuthor(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Git.ListCommits(context.Background(), "atlassian/stash-example-plugin", scm.CommitListOptions{Ref: "master", Author: "aahmed"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -231,3 +296,67 @@ func TestGitCompareCommits(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestGitCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/branches/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/src").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				return false, err
+			}
+			form := req.MultipartForm
+			file, err := form.File["bin/run"][0].Open()
+			if err != nil {
+				return false, err
+			}
+			defer file.Close()
+			content, _ := ioutil.ReadAll(file)
+			return form.Value["message"][0] == "Add run script" &&
+				form.Value["branch"][0] == "master" &&
+				form.Value["parents"][0] == "a6e5e7d797edf751cbd839d6bd4aef86c941eec9" &&
+				form.Value["author"][0] == "Jane Doe <jane@example.com>" &&
+				form.Value["files"][0] == "CHANGELOG" &&
+				string(content) == "#!/bin/sh\n", nil
+		}).
+		Reply(201).
+		SetHeader("Location", "https://api.bitbucket.org/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9")
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/commit/a6e5e7d797edf751cbd839d6bd4aef86c941eec9").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	input := &scm.CommitFilesInput{
+		Branch:  "master",
+		Message: "Add run script",
+		Author:  &scm.Signature{Name: "Jane Doe", Email: "jane@example.com"},
+		Files: []*scm.FileChange{
+			{Action: scm.FileActionCreate, Path: "bin/run", Content: []byte("#!/bin/sh\n"), Executable: true},
+			{Action: scm.FileActionDelete, Path: "CHANGELOG"},
+		},
+	}
+	got, _, err := client.Git.CommitFiles(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -65,8 +65,110 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 	return nil, nil, scm.ErrNotSupported
 }
 
+// List returns the issues of the repository issue tracker. Labels
+// and mentions cannot be used to filter issues on Bitbucket.
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the issue tracker has no labels and does not track mentions.
+	if len(opts.Labels) != 0 || opts.Mentioned != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/issues?%s", repo, encodeIssueListOptions(opts))
+	out := new(issues)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	err = copyPagination(out.pagination, res)
+	return convertIssueList(out), res, err
+}
+
+type issues struct {
+	pagination
+	Values []*issue `json:"values"`
+}
+
+type issue struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	Kind    string `json:"kind"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Reporter  *issueUser `json:"reporter"`
+	Assignee  *issueUser `json:"assignee"`
+	Milestone *struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"milestone"`
+	Links struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type issueUser struct {
+	AccountID   string `json:"account_id"`
+	DisplayName string `json:"display_name"`
+	Links       struct {
+		Avatar link `json:"avatar"`
+	} `json:"links"`
+}
+
+func convertIssueList(from *issues) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from.Values {
+		to = append(to, convertIssue(v))
+	}
+	return to
+}
+
+func convertIssue(from *issue) *scm.Issue {
+	to := &scm.Issue{
+		Number:  from.ID,
+		Title:   from.Title,
+		Body:    from.Content.Raw,
+		Link:    from.Links.HTML.Href,
+		State:   from.State,
+		Closed:  issueClosed(from.State),
+		Type:    from.Kind,
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+	if from.Reporter != nil {
+		to.Author = convertIssueUser(from.Reporter)
+	}
+	if from.Assignee != nil {
+		to.Assignees = []scm.User{convertIssueUser(from.Assignee)}
+	}
+	if from.Milestone != nil {
+		to.Milestone = &scm.Milestone{
+			Number: from.Milestone.ID,
+			ID:     from.Milestone.ID,
+			Title:  from.Milestone.Name,
+		}
+	}
+	return to
+}
+
+func convertIssueUser(from *issueUser) scm.User {
+	return scm.User{
+		Login:  from.AccountID,
+		Name:   from.DisplayName,
+		Avatar: from.Links.Avatar.Href,
+	}
+}
+
+// issueClosed returns true if the issue state is one of the
+// resolved states of the Bitbucket issue tracker.
+func issueClosed(state string) bool {
+	switch state {
+	case "new", "open", "on hold":
+		return false
+	default:
+		return true
+	}
 }
 
 func convertIssueCommentList(from []*issueComment) []*scm.Comment {
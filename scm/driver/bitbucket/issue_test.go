@@ -6,9 +6,13 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
 )
 
 func TestIssueFind(t *testing.T) {
@@ -26,9 +30,48 @@ func TestIssueCommentFind(t *testing.T) {
 }
 
 func TestIssueList(t *testing.T) {
-	_, _, err := NewDefault().Issues.List(context.Background(), "", scm.IssueListOptions{})
-	if err != nil && err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/octocat/hello-world/issues").
+		MatchParam("pagelen", "30").
+		MatchParam("page", "1").
+		MatchParam("q", `assignee.account_id = "557058:7c8b0e1e-3b1a-4a0f-8f1e-8a5e0d9f2c11" AND milestone.id = 3`).
+		MatchParam("sort", "-updated_on").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	opts := scm.IssueListOptions{
+		Page:      1,
+		Size:      30,
+		Assignee:  "557058:7c8b0e1e-3b1a-4a0f-8f1e-8a5e0d9f2c11",
+		Milestone: 3,
+		Sort:      "updated",
+	}
+
+	client := NewDefault()
+	got, _, err := client.Issues.List(context.Background(), "octocat/hello-world", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueListLabels(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Issues.List(context.Background(), "octocat/hello-world", scm.IssueListOptions{Labels: []string{"bug"}})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
 	}
 }
 
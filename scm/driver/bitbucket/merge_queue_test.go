@@ -0,0 +1,19 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestMergeQueueAdd(t *testing.T) {
+	_, _, err := NewDefault().MergeQueues.Add(context.Background(), "atlassian/atlaskit", 1, nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -166,6 +166,17 @@ func (s *pullService) ListComments(ctx context.Context, repo string, index int,
 	return convertPRCommentList(out), res, err
 }
 
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/commits?%s", repo, number, encodeListOptions(opts))
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	err = copyPagination(out.pagination, res)
+	return convertCommitList(out), res, err
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/diffstat?%s", repo, number, encodeListOptions(opts))
 	out := new(diffstats)
@@ -220,10 +231,34 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int, option
 	return res, err
 }
 
+// EnableAutoMerge is not supported, since the auto-merge of
+// Bitbucket Cloud is not available through the api.
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, opts *scm.AutoMergeOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	in := map[string]bool{"draft": draft}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, prInput *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RebasePullRequest(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -244,25 +279,52 @@ type prPatchName struct {
 	Name string `json:"name"`
 }
 
+type prPatchRepository struct {
+	FullName string `json:"full_name"`
+}
+
 type prPatchBranch struct {
-	Branch prPatchName `json:"branch,omitempty"`
+	Branch     prPatchName        `json:"branch,omitempty"`
+	Repository *prPatchRepository `json:"repository,omitempty"`
 }
 
 type prInput struct {
-	Title   string        `json:"title,omitempty"`
-	Source  prPatchBranch `json:"source,omitempty"`
-	Project string
+	Title             string         `json:"title,omitempty"`
+	Description       string         `json:"description,omitempty"`
+	Source            prPatchBranch  `json:"source,omitempty"`
+	Destination       *prPatchBranch `json:"destination,omitempty"`
+	Draft             bool           `json:"draft,omitempty"`
+	CloseSourceBranch *bool          `json:"close_source_branch,omitempty"`
+	Project           string
 }
 
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	// reviewers are identified by account id rather than login,
+	// and pull requests have no labels, assignees or milestone.
+	if len(input.Labels) != 0 || len(input.Assignees) != 0 || len(input.Reviewers) != 0 || input.Milestone != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
 	path := fmt.Sprintf("2.0/repositories/%s/pullrequests", repo)
 	in := &prInput{
-		Title: input.Title,
+		Title:       input.Title,
+		Description: input.Body,
 		Source: prPatchBranch{
 			Branch: prPatchName{
 				Name: input.Head,
 			},
 		},
+		Draft:             input.Draft,
+		CloseSourceBranch: input.RemoveSourceBranch,
+	}
+	namespace, name := scm.Split(repo)
+	if owner, branch := scm.SplitHead(input.Head); owner != "" {
+		in.Source.Branch.Name = branch
+		if owner != namespace {
+			in.Source.Repository = &prPatchRepository{FullName: scm.Join(owner, name)}
+		}
+	}
+	if input.Base != "" {
+		in.Destination = &prPatchBranch{Branch: prPatchName{Name: input.Base}}
 	}
 	out := new(pullRequest)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -273,6 +335,23 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return responsePR, res, err
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// ListReviewers returns the reviewers of the pull request, with
+// their review state taken from the participants.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d", repo, number)
+	out := new(pullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReviewerList(out), res, err
+}
+
 func (s *pullService) RequestReview(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -308,18 +387,23 @@ type prDestination struct {
 type pullRequest struct {
 	ID int `json:"id"`
 	//Version     int    `json:"version"`
-	Title        string        `json:"title"`
-	Description  string        `json:"description"`
-	State        string        `json:"state"`
-	CreatedDate  time.Time     `json:"created_on"`
-	UpdatedDate  time.Time     `json:"updated_on"`
-	Source       prSource      `json:"source"`
-	Destination  prDestination `json:"destination"`
-	Locked       bool          `json:"locked"`
-	Author       user          `json:"author"`
-	Reviewers    []user        `json:"reviewers"`
-	Participants []user        `json:"participants"`
-	Links        struct {
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	State        string          `json:"state"`
+	CreatedDate  time.Time       `json:"created_on"`
+	UpdatedDate  time.Time       `json:"updated_on"`
+	Source       prSource        `json:"source"`
+	Destination  prDestination   `json:"destination"`
+	Locked       bool            `json:"locked"`
+	Draft        bool            `json:"draft"`
+	Author       user            `json:"author"`
+	Reviewers    []user          `json:"reviewers"`
+	Participants []prParticipant `json:"participants"`
+	MergeCommit  *struct {
+		Hash string `json:"hash"`
+	} `json:"merge_commit"`
+	ClosedBy *user `json:"closed_by"`
+	Links    struct {
 		DiffStat link `json:"diffstat"`
 		Self     link `json:"self"`
 		Diff     link `json:"diff"`
@@ -327,6 +411,14 @@ type pullRequest struct {
 	} `json:"links"`
 }
 
+type prParticipant struct {
+	User           user      `json:"user"`
+	Role           string    `json:"role"`
+	Approved       bool      `json:"approved"`
+	State          string    `json:"state"`
+	ParticipatedOn time.Time `json:"participated_on"`
+}
+
 type pullRequests struct {
 	pagination
 	Values []*pullRequest `json:"values"`
@@ -347,7 +439,7 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 	fork := "false"
 	closed := strings.ToLower(from.State) != "open"
 	baseRef, headRef := findRefs(from)
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number:   from.ID,
 		Title:    from.Title,
 		Body:     from.Description,
@@ -363,6 +455,7 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 		State:    strings.ToLower(from.State),
 		Closed:   closed,
 		Merged:   from.State == "MERGED",
+		Draft:    from.Draft,
 		Created:  from.CreatedDate,
 		Updated:  from.UpdatedDate,
 		Author: scm.User{
@@ -372,7 +465,79 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 			Link:   from.Author.Links.Self.Href,
 			Avatar: from.Author.Links.Avatar.Href,
 		},
+		FromFork:       from.Source.Repository.FullName != "" && from.Source.Repository.FullName != from.Destination.Repository.FullName,
+		ReviewDecision: convertReviewDecision(from),
+	}
+	if from.MergeCommit != nil {
+		to.MergeSha = from.MergeCommit.Hash
+	}
+	// the user closing the pull request also merges it.
+	if to.Merged && from.ClosedBy != nil {
+		to.MergedBy = convertUser(from.ClosedBy)
+	}
+	return to
+}
+
+func convertReviewerList(from *pullRequest) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	byAccount := map[string]*scm.Reviewer{}
+	add := func(u *user) *scm.Reviewer {
+		key := u.AccountID
+		if key == "" {
+			key = u.Login
+		}
+		if reviewer, ok := byAccount[key]; ok {
+			return reviewer
+		}
+		reviewer := &scm.Reviewer{
+			Kind:  scm.ReviewerKindUser,
+			User:  *convertUser(u),
+			State: scm.ReviewStatePending,
+		}
+		byAccount[key] = reviewer
+		to = append(to, reviewer)
+		return reviewer
+	}
+	for i := range from.Reviewers {
+		add(&from.Reviewers[i])
+	}
+	for i, p := range from.Participants {
+		if p.Role != "REVIEWER" {
+			continue
+		}
+		reviewer := add(&from.Participants[i].User)
+		switch {
+		case p.State == "changes_requested":
+			reviewer.State = scm.ReviewStateChangesRequested
+		case p.Approved:
+			reviewer.State = scm.ReviewStateApproved
+		default:
+			continue
+		}
+		reviewer.Reviewed = p.ParticipatedOn
+	}
+	return to
+}
+
+// convertReviewDecision derives the review decision from the
+// participants, since the api does not report it.
+func convertReviewDecision(from *pullRequest) scm.ReviewDecision {
+	approved := false
+	for _, p := range from.Participants {
+		switch {
+		case p.State == "changes_requested":
+			return scm.ReviewDecisionChangesRequested
+		case p.Approved:
+			approved = true
+		}
+	}
+	if approved {
+		return scm.ReviewDecisionApproved
+	}
+	if len(from.Reviewers) != 0 {
+		return scm.ReviewDecisionReviewRequired
 	}
+	return scm.ReviewDecisionUnknown
 }
 
 func convertPullRequestBranch(ref string, sha string, repo repository) scm.PullRequestBranch {
@@ -54,6 +54,36 @@ func TestPullList(t *testing.T) {
 	}
 }
 
+func TestPullListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/pullrequests/1/commits").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListCommits(context.Background(), "atlassian/stash-example-plugin", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
 func TestPullListChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -132,3 +162,146 @@ func TestPullCreate(t *testing.T) {
 		}
 	}
 }
+
+func TestPullCreateFromFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("2.0/repositories/octocat/hello-world/pullrequests").
+		JSON(map[string]interface{}{
+			"title":       "Amazing new feature",
+			"description": "Please pull these awesome changes in!",
+			"source": map[string]interface{}{
+				"branch":     map[string]string{"name": "new-feature"},
+				"repository": map[string]string{"full_name": "hubot/hello-world"},
+			},
+			"destination": map[string]interface{}{
+				"branch": map[string]string{"name": "master"},
+			},
+			"close_source_branch": true,
+			"Project":             "",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_create.json")
+
+	closeSourceBranch := true
+	input := &scm.PullRequestInput{
+		Title:              "Amazing new feature",
+		Body:               "Please pull these awesome changes in!",
+		Head:               "hubot:new-feature",
+		Base:               "master",
+		RemoveSourceBranch: &closeSourceBranch,
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullCreateLabels(t *testing.T) {
+	input := &scm.PullRequestInput{
+		Title:  "Amazing new feature",
+		Head:   "new-feature",
+		Labels: []string{"bug"},
+	}
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviewers.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.PullRequests.ListReviewers(context.Background(), "atlassian/atlaskit", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestTeamReview(t *testing.T) {
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.RequestTeamReview(context.Background(), "atlassian/atlaskit", 1, []string{"devs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullReviewDecision(t *testing.T) {
+	tests := []struct {
+		from *pullRequest
+		want scm.ReviewDecision
+	}{
+		{
+			from: &pullRequest{},
+			want: scm.ReviewDecisionUnknown,
+		},
+		{
+			from: &pullRequest{Reviewers: []user{{Login: "jane"}}},
+			want: scm.ReviewDecisionReviewRequired,
+		},
+		{
+			from: &pullRequest{Participants: []prParticipant{{Approved: true}}},
+			want: scm.ReviewDecisionApproved,
+		},
+		{
+			from: &pullRequest{Participants: []prParticipant{{Approved: true}, {State: "changes_requested"}}},
+			want: scm.ReviewDecisionChangesRequested,
+		},
+	}
+	for i, test := range tests {
+		if got := convertReviewDecision(test.from); got != test.want {
+			t.Errorf("Want review decision %q, got %q at index %d", test.want, got, i)
+		}
+	}
+}
+
+func TestPullSetDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/atlaskit/pullrequests/4982").
+		JSON(map[string]bool{"draft": true}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_create.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.PullRequests.SetDraft(context.Background(), "atlassian/atlaskit", 4982, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullEnableAutoMerge(t *testing.T) {
+	_, err := NewDefault().PullRequests.EnableAutoMerge(context.Background(), "atlassian/atlaskit", 1, nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
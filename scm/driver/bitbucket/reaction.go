@@ -0,0 +1,63 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type reactionService struct {
+	client *wrapper
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -224,10 +224,30 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	return nil, nil, nil
 }
 
+func (s *repositoryService) CreateLabel(context.Context, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UpdateLabel(context.Context, string, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteLabel(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *repositoryService) Delete(context.Context, string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) SetDefaultBranch(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("2.0/repositories/%s", repo)
+	in := map[string]interface{}{
+		"mainbranch": map[string]string{"name": branch},
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 // Find returns the repository by name.
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/repositories/%s", repo)
@@ -271,6 +271,25 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositorySetDefaultBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/atlassian/stash-example-plugin").
+		JSON(map[string]interface{}{
+			"mainbranch": map[string]string{"name": "develop"},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Repositories.SetDefaultBranch(context.Background(), "atlassian/stash-example-plugin", "develop")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestRepositoryHookCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,133 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// searchService implements the code search of a workspace, which
+// is the only search supported by Bitbucket Cloud.
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCode, *scm.Response, error) {
+	workspace := opts.Org
+	query := opts.Query
+	if opts.Repo != "" {
+		var name string
+		workspace, name = scm.Split(opts.Repo)
+		query += " repo:" + name
+	}
+	if workspace == "" {
+		return nil, nil, errors.New("bitbucket: code search requires a workspace or repository")
+	}
+	params := url.Values{}
+	params.Set("search_query", query)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("2.0/workspaces/%s/search/code?%s", workspace, params.Encode())
+	out := new(codeSearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	err = copyPagination(out.pagination, res)
+	return convertSearchCodeList(out), res, err
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCommit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.Repository, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) PullRequests(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type codeSearchResults struct {
+	pagination
+	Values []*codeSearchResult `json:"values"`
+}
+
+type codeSearchResult struct {
+	ContentMatches []struct {
+		Lines []struct {
+			Line     int `json:"line"`
+			Segments []struct {
+				Text string `json:"text"`
+			} `json:"segments"`
+		} `json:"lines"`
+	} `json:"content_matches"`
+	File struct {
+		Path  string `json:"path"`
+		Links struct {
+			Self link `json:"self"`
+		} `json:"links"`
+	} `json:"file"`
+}
+
+func convertSearchCodeList(from *codeSearchResults) []*scm.SearchCode {
+	to := []*scm.SearchCode{}
+	for _, v := range from.Values {
+		to = append(to, convertSearchCode(v))
+	}
+	return to
+}
+
+func convertSearchCode(from *codeSearchResult) *scm.SearchCode {
+	to := &scm.SearchCode{
+		Path: from.File.Path,
+	}
+	// the link of the file is the only reference to the repository
+	// and the commit, for example:
+	// https://api.bitbucket.org/2.0/repositories/atlassian/demo/src/ad6964b/src/main.go
+	href := from.File.Links.Self.Href
+	if i := strings.Index(href, "/repositories/"); i != -1 {
+		parts := strings.SplitN(href[i+len("/repositories/"):], "/", 5)
+		if len(parts) >= 4 && parts[2] == "src" {
+			to.Repository = scm.Repository{
+				Namespace: parts[0],
+				Name:      parts[1],
+				FullName:  scm.Join(parts[0], parts[1]),
+				Link:      fmt.Sprintf("https://bitbucket.org/%s/%s", parts[0], parts[1]),
+			}
+			to.Sha = parts[3]
+			to.Link = fmt.Sprintf("%s/src/%s/%s", to.Repository.Link, to.Sha, to.Path)
+		}
+	}
+	for _, match := range from.ContentMatches {
+		fragment := new(scm.SearchFragment)
+		var lines []string
+		for i, line := range match.Lines {
+			if i == 0 {
+				fragment.Line = line.Line
+			}
+			var text strings.Builder
+			for _, segment := range line.Segments {
+				text.WriteString(segment.Text)
+			}
+			lines = append(lines, text.String())
+		}
+		fragment.Text = strings.Join(lines, "\n")
+		to.Fragments = append(to.Fragments, fragment)
+	}
+	return to
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/workspaces/atlassian/search/code").
+		MatchParam("search_query", "errors repo:demo").
+		Reply(200).
+		Type("application/json").
+		File("testdata/search_code.json")
+
+	client := NewDefault()
+	opts := scm.SearchQueryOptions{Query: "errors", Repo: "atlassian/demo"}
+	got, _, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.SearchCode{}
+	raw, _ := ioutil.ReadFile("testdata/search_code.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestSearchCodeWorkspace(t *testing.T) {
+	_, _, err := NewDefault().Search.Code(context.Background(), scm.SearchQueryOptions{Query: "errors"})
+	if err == nil {
+		t.Errorf("Expect error when the workspace is missing")
+	}
+}
+
+func TestSearchRepositories(t *testing.T) {
+	_, _, err := NewDefault().Search.Repositories(context.Background(), scm.SearchQueryOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
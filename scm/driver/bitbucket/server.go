@@ -0,0 +1,16 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+// Find returns the server details. Bitbucket Cloud is not versioned.
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	return &scm.ServerInfo{Product: scm.DriverBitbucket.String(), Edition: scm.EditionCloud}, nil, nil
+}
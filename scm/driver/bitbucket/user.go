@@ -36,6 +36,22 @@ func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	info := &scm.TokenInfo{
+		User:   user,
+		Scopes: res.Scopes,
+	}
+	// only OAuth access tokens report their scopes.
+	if res.Scopes != nil {
+		info.Type = scm.TokenTypeOAuth
+	}
+	return info, res, nil
+}
+
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
 	return "", nil, scm.ErrNotSupported
 }
@@ -73,3 +73,33 @@ func TestUserFindEmail(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestUserFindToken(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user").
+		Reply(200).
+		Type("application/json").
+		SetHeader("X-OAuth-Scopes", "pullrequest:write, repository:admin").
+		File("testdata/user.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.FindToken(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.TokenInfo{
+		Scopes: []string{"pullrequest:write", "repository:admin"},
+		Type:   scm.TokenTypeOAuth,
+	}
+	raw, _ := ioutil.ReadFile("testdata/user.json.golden")
+	json.Unmarshal(raw, &want.User)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
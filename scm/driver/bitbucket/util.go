@@ -5,9 +5,11 @@
 package bitbucket
 
 import (
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -63,6 +65,9 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
 	return params.Encode()
 }
 
@@ -74,10 +79,39 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
-	if opts.Open && opts.Closed {
-		params.Set("state", "all")
-	} else if opts.Closed {
-		params.Set("state", "closed")
+	// the issue tracker has no state parameter, so the states
+	// are filtered with the query.
+	var query []string
+	if opts.Open && !opts.Closed {
+		query = append(query, `(state = "new" OR state = "open" OR state = "on hold")`)
+	} else if opts.Closed && !opts.Open {
+		query = append(query, `state != "new" AND state != "open" AND state != "on hold"`)
+	}
+	if opts.Assignee != "" {
+		query = append(query, fmt.Sprintf("assignee.account_id = %q", opts.Assignee))
+	}
+	if opts.Author != "" {
+		query = append(query, fmt.Sprintf("reporter.account_id = %q", opts.Author))
+	}
+	if opts.Milestone != 0 {
+		query = append(query, fmt.Sprintf("milestone.id = %d", opts.Milestone))
+	}
+	if opts.UpdatedAfter != nil {
+		query = append(query, "updated_on >= "+opts.UpdatedAfter.UTC().Format(scm.SearchTimeFormat))
+	}
+	if len(query) != 0 {
+		params.Set("q", strings.Join(query, " AND "))
+	}
+	if opts.Sort != "" {
+		sort := opts.Sort
+		switch sort {
+		case "created", "updated":
+			sort += "_on"
+		}
+		if !opts.Ascending {
+			sort = "-" + sort
+		}
+		params.Set("sort", sort)
 	}
 	return params.Encode()
 }
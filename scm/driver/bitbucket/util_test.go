@@ -6,6 +6,7 @@ package bitbucket
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -35,6 +36,20 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Path(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Page: 10,
+		Size: 30,
+		Ref:  "master",
+		Path: "docs/README.md",
+	}
+	want := "page=10&pagelen=30&path=docs%2FREADME.md"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -42,7 +57,33 @@ func Test_encodeIssueListOptions(t *testing.T) {
 		Open:   true,
 		Closed: true,
 	}
-	want := "page=10&pagelen=30&state=all"
+	want := "page=10&pagelen=30"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	since := time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)
+	opts := scm.IssueListOptions{
+		Author:       "557058:2ab1d4c6",
+		UpdatedAfter: &since,
+		Sort:         "created",
+		Ascending:    true,
+	}
+	want := "q=reporter.account_id+%3D+%22557058%3A2ab1d4c6%22+AND+updated_on+%3E%3D+2018-09-01T00%3A00%3A00Z&sort=created_on"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
+func Test_encodeIssueListOptions_State(t *testing.T) {
+	opts := scm.IssueListOptions{
+		Open: true,
+	}
+	want := "q=%28state+%3D+%22new%22+OR+state+%3D+%22open%22+OR+state+%3D+%22on+hold%22%29"
 	got := encodeIssueListOptions(opts)
 	if got != want {
 		t.Errorf("Want encoded issue list options %q, got %q", want, got)
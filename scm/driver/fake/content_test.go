@@ -2,10 +2,13 @@ package fake_test
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/jenkins-x/go-scm/scm"
 	"github.com/jenkins-x/go-scm/scm/driver/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,3 +80,41 @@ func TestContentWithRefs(t *testing.T) {
 		t.Logf("loaded repo %s path %s ref %s got %s\n", repo, ref, path, text)
 	}
 }
+
+func TestCommitFiles(t *testing.T) {
+	client, fakeData := fake.NewDefault()
+	dir, err := ioutil.TempDir("", "fake-content")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fakeData.ContentDir = dir
+
+	ctx := context.Background()
+	repo := "myorg/myrepo"
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, repo), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, repo, "old.txt"), []byte("old"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, repo, "CHANGELOG"), []byte("changes"), 0644))
+
+	_, _, err = client.Git.CommitFiles(ctx, repo, &scm.CommitFilesInput{
+		Branch:  "master",
+		Message: "update files",
+		Files: []*scm.FileChange{
+			{Action: scm.FileActionCreate, Path: "README.md", Content: []byte("hello")},
+			{Action: scm.FileActionMove, Path: "new.txt", PreviousPath: "old.txt"},
+			{Action: scm.FileActionDelete, Path: "CHANGELOG"},
+		},
+	})
+	require.NoError(t, err)
+
+	c, _, err := client.Contents.Find(ctx, repo, "README.md", "")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(c.Data))
+
+	c, _, err = client.Contents.Find(ctx, repo, "new.txt", "")
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(c.Data))
+
+	_, _, err = client.Contents.Find(ctx, repo, "old.txt", "")
+	assert.Error(t, err, "moved file should be removed")
+	_, _, err = client.Contents.Find(ctx, repo, "CHANGELOG", "")
+	assert.Error(t, err, "deleted file should be removed")
+}
@@ -66,6 +66,10 @@ type Data struct {
 	// and values map SHA to content
 	RemoteFiles map[string]map[string]string
 
+	// merge queue entries of each repository in queue order
+	// org/repo:[]entry
+	MergeQueues map[string][]*scm.MergeQueueEntry
+
 	// A list of refs that got deleted via DeleteRef
 	RefsDeleted []DeletedRef
 
@@ -103,6 +107,7 @@ func NewData() *Data {
 		Commits:                   map[string]*scm.Commit{},
 		MilestoneMap:              map[string]int{},
 		CommitMap:                 map[string][]scm.Commit{},
+		MergeQueues:               map[string][]*scm.MergeQueueEntry{},
 		RemoteFiles:               map[string]map[string]string{},
 		TestRef:                   "abcde",
 		IssueLabelsAdded:          []string{},
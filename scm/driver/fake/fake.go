@@ -27,11 +27,16 @@ func NewDefault() (*scm.Client, *Data) {
 	client.Deployments = &deploymentService{client: client, data: data}
 	client.Git = &gitService{client: client, data: data}
 	client.Issues = &issueService{client: client, data: data}
+	client.MergeQueues = &mergeQueueService{client: client, data: data}
 	client.Organizations = &organizationService{client: client, data: data}
 	client.PullRequests = &pullService{client: client, data: data}
 	client.Repositories = &repositoryService{client: client, data: data}
 	client.Releases = &releaseService{client: client, data: data}
 	client.Reviews = &reviewService{client: client, data: data}
+	client.Approvals = &approvalService{client: client, data: data}
+	client.Reactions = &reactionService{client: client, data: data}
+	client.Search = &searchService{client: client, data: data}
+	client.Server = &serverService{client: client, data: data}
 	client.Users = &userService{client: client, data: data}
 
 	client.Username = data.CurrentUser.Login
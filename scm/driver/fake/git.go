@@ -22,6 +22,10 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	panic("implement me")
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	f := s.data
 	paths := strings.SplitN(repo, "/", 2)
@@ -38,6 +42,14 @@ func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Re
 	panic("implement me")
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) RenameBranch(ctx context.Context, repo, branch, name string) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, SHA string) (*scm.Commit, *scm.Response, error) {
 	f := s.data
 	return f.Commits[SHA], nil, nil
@@ -47,6 +59,10 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	panic("implement me")
 }
 
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	panic("implement me")
 }
@@ -66,3 +82,69 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	panic("implement me")
 }
+
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CommitFiles applies the file changes to the files of the fake content
+// service in the data.ContentDir
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	contents := contentService{client: s.client, data: s.data}
+	for _, file := range input.Files {
+		var err error
+		switch file.Action {
+		case scm.FileActionDelete:
+			_, err = contents.Delete(ctx, repo, file.Path, "")
+		case scm.FileActionMove:
+			data := file.Content
+			if data == nil {
+				var old *scm.Content
+				if old, _, err = contents.Find(ctx, repo, file.PreviousPath, ""); err != nil {
+					return nil, nil, err
+				}
+				data = old.Data
+			}
+			if _, err = contents.Create(ctx, repo, file.Path, &scm.ContentParams{Data: data}); err == nil {
+				_, err = contents.Delete(ctx, repo, file.PreviousPath, "")
+			}
+		default:
+			_, err = contents.Create(ctx, repo, file.Path, &scm.ContentParams{Data: file.Content})
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	commit := &scm.Commit{
+		Sha:     s.data.TestRef,
+		Message: input.Message,
+	}
+	if input.Author != nil {
+		commit.Author = *input.Author
+	}
+	return commit, nil, nil
+}
+
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
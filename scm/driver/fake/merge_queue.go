@@ -0,0 +1,80 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// mergeQueueService keeps the queues of all the branches of a
+// repository in a single list, the position of an entry is its index
+// among the entries of the same branch.
+type mergeQueueService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *mergeQueueService) List(ctx context.Context, repo, branch string, opts scm.ListOptions) ([]*scm.MergeQueueEntry, *scm.Response, error) {
+	entries := s.branchEntries(repo, branch)
+	returnStart, returnEnd := paginated(opts.Page, opts.Size, len(entries))
+	return entries[returnStart:returnEnd], nil, nil
+}
+
+func (s *mergeQueueService) Add(ctx context.Context, repo string, number int, opts *scm.MergeQueueOptions) (*scm.MergeQueueEntry, *scm.Response, error) {
+	pr, ok := s.data.PullRequests[number]
+	if !ok || pr == nil {
+		return nil, nil, fmt.Errorf("pull request %d not found", number)
+	}
+	for _, entry := range s.data.MergeQueues[repo] {
+		if entry.Number == number {
+			return nil, nil, fmt.Errorf("pull request %d is already queued", number)
+		}
+	}
+	if opts != nil && opts.SHA != "" && opts.SHA != pr.Head.Sha {
+		return nil, nil, fmt.Errorf("pull request %d head is %s, not %s", number, pr.Head.Sha, opts.SHA)
+	}
+	entry := &scm.MergeQueueEntry{
+		ID:      strconv.Itoa(number),
+		Number:  number,
+		State:   "queued",
+		Sha:     pr.Head.Sha,
+		BaseRef: pr.Base.Ref,
+		Author:  s.data.CurrentUser,
+		Created: time.Now(),
+	}
+	if opts != nil && opts.Jump {
+		s.data.MergeQueues[repo] = append([]*scm.MergeQueueEntry{entry}, s.data.MergeQueues[repo]...)
+	} else {
+		s.data.MergeQueues[repo] = append(s.data.MergeQueues[repo], entry)
+	}
+	s.branchEntries(repo, entry.BaseRef)
+	return entry, nil, nil
+}
+
+func (s *mergeQueueService) Remove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	entries := s.data.MergeQueues[repo]
+	for i, entry := range entries {
+		if entry.Number == number {
+			s.data.MergeQueues[repo] = append(entries[:i:i], entries[i+1:]...)
+			s.branchEntries(repo, entry.BaseRef)
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("pull request %d is not queued", number)
+}
+
+// branchEntries returns the entries of the queue of the branch,
+// updating their positions.
+func (s *mergeQueueService) branchEntries(repo, branch string) []*scm.MergeQueueEntry {
+	entries := []*scm.MergeQueueEntry{}
+	for _, entry := range s.data.MergeQueues[repo] {
+		if entry.BaseRef == branch {
+			entries = append(entries, entry)
+			entry.Position = len(entries)
+		}
+	}
+	return entries
+}
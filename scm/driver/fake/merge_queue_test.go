@@ -0,0 +1,57 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestMergeQueue(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+	for _, number := range []int{1, 2, 3} {
+		data.PullRequests[number] = &scm.PullRequest{
+			Number: number,
+			Base:   scm.PullRequestBranch{Ref: "master"},
+			Head:   scm.PullRequestBranch{Sha: "abcde"},
+		}
+	}
+	data.PullRequests[3].Base.Ref = "release"
+
+	if _, _, err := client.MergeQueues.Add(ctx, "org/repo", 1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.MergeQueues.Add(ctx, "org/repo", 3, nil); err != nil {
+		t.Fatal(err)
+	}
+	entry, _, err := client.MergeQueues.Add(ctx, "org/repo", 2, &scm.MergeQueueOptions{SHA: "abcde", Jump: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Position != 1 {
+		t.Errorf("want position 1, got %d", entry.Position)
+	}
+	if _, _, err := client.MergeQueues.Add(ctx, "org/repo", 1, nil); err == nil {
+		t.Errorf("want error adding a queued pull request")
+	}
+
+	entries, _, err := client.MergeQueues.List(ctx, "org/repo", "master", scm.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Number != 2 || entries[1].Number != 1 || entries[1].Position != 2 {
+		t.Errorf("unexpected entries %v", entries)
+	}
+
+	if _, err := client.MergeQueues.Remove(ctx, "org/repo", 2); err != nil {
+		t.Fatal(err)
+	}
+	entries, _, _ = client.MergeQueues.List(ctx, "org/repo", "master", scm.ListOptions{})
+	if len(entries) != 1 || entries[0].Number != 1 || entries[0].Position != 1 {
+		t.Errorf("unexpected entries %v", entries)
+	}
+	if _, err := client.MergeQueues.Remove(ctx, "org/repo", 2); err == nil {
+		t.Errorf("want error removing a pull request which is not queued")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -50,6 +51,17 @@ func (s *pullService) ListChanges(ctx context.Context, repo string, number int,
 	return f.PullRequestChanges[number][returnStart:returnEnd], nil, nil
 }
 
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	commits := s.data.CommitMap[fmt.Sprintf("%s#%d", repo, number)]
+	returnStart, returnEnd := paginated(opts.Page, opts.Size, len(commits))
+	out := []*scm.Commit{}
+	for i := range commits[returnStart:returnEnd] {
+		c := commits[returnStart+i]
+		out = append(out, &c)
+	}
+	return out, nil, nil
+}
+
 func (s *pullService) ListComments(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	f := s.data
 	return append([]*scm.Comment{}, f.PullRequestComments[number]...), nil, nil
@@ -126,17 +138,60 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int, mergeO
 	if !ok || pr == nil {
 		return nil, fmt.Errorf("pull request %d not found", number)
 	}
+	now := time.Now()
 	pr.Merged = true
+	pr.MergedAt = &now
 	pr.State = "closed"
 	pr.Closed = true
+	pr.ClosedAt = &now
 	pr.Mergeable = false
 	return nil, nil
 }
 
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, opts *scm.AutoMergeOptions) (*scm.Response, error) {
+	pr, ok := s.data.PullRequests[number]
+	if !ok || pr == nil {
+		return nil, fmt.Errorf("pull request %d not found", number)
+	}
+	pr.AutoMerge = &scm.AutoMerge{}
+	if opts != nil {
+		pr.AutoMerge.MergeMethod = opts.MergeMethod
+		pr.AutoMerge.CommitTitle = opts.CommitTitle
+		pr.AutoMerge.CommitMessage = opts.CommitMessage
+	}
+	return nil, nil
+}
+
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	pr, ok := s.data.PullRequests[number]
+	if !ok || pr == nil {
+		return nil, fmt.Errorf("pull request %d not found", number)
+	}
+	pr.AutoMerge = nil
+	return nil, nil
+}
+
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	pr, ok := s.data.PullRequests[number]
+	if !ok || pr == nil {
+		return nil, fmt.Errorf("pull request %d not found", number)
+	}
+	pr.Draft = draft
+	return nil, nil
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, prInput *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	panic("implement me")
 }
 
+func (s *pullService) UpdatePullRequestBranch(context.Context, string, int, *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RebasePullRequest(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
 	panic("implement me")
 }
@@ -204,6 +259,42 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// ListReviewers returns the reviewers of the pull request, with the
+// state of their latest review.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	f := s.data
+	pr, exists := f.PullRequests[number]
+	if !exists {
+		return nil, nil, fmt.Errorf("Pull request number %d does not exit", number)
+	}
+	answer := []*scm.Reviewer{}
+	byLogin := map[string]*scm.Reviewer{}
+	for _, u := range pr.Reviewers {
+		reviewer := &scm.Reviewer{Kind: scm.ReviewerKindUser, User: u, State: scm.ReviewStatePending}
+		byLogin[u.Login] = reviewer
+		answer = append(answer, reviewer)
+	}
+	for _, r := range f.Reviews[number] {
+		reviewer, ok := byLogin[r.Author.Login]
+		if !ok {
+			reviewer = &scm.Reviewer{Kind: scm.ReviewerKindUser, User: r.Author}
+			byLogin[r.Author.Login] = reviewer
+			answer = append(answer, reviewer)
+		}
+		reviewer.State = r.State
+		reviewer.Reviewed = r.Created
+	}
+	return answer, nil, nil
+}
+
 func (s *pullService) Create(_ context.Context, fullName string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	f := s.data
 	f.PullRequestID++
@@ -229,6 +320,25 @@ func (s *pullService) Create(_ context.Context, fullName string, input *scm.Pull
 		Head: scm.PullRequestBranch{
 			Ref: input.Head,
 		},
+		Draft:     input.Draft,
+		Milestone: scm.Milestone{Number: input.Milestone},
+	}
+	if owner, branch := scm.SplitHead(input.Head); owner != "" {
+		answer.Head.Ref = branch
+		answer.Head.Repo = scm.Repository{
+			Namespace: owner,
+			Name:      name,
+			FullName:  scm.Join(owner, name),
+		}
+	}
+	for _, l := range input.Labels {
+		answer.Labels = append(answer.Labels, &scm.Label{Name: l})
+	}
+	for _, a := range input.Assignees {
+		answer.Assignees = append(answer.Assignees, scm.User{Login: a})
+	}
+	for _, r := range input.Reviewers {
+		answer.Reviewers = append(answer.Reviewers, scm.User{Login: r})
 	}
 	f.PullRequestsCreated[f.PullRequestID] = input
 	f.PullRequests[f.PullRequestID] = answer
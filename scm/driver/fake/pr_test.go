@@ -82,3 +82,135 @@ func extractChangeFiles(ch []*scm.Change) []string {
 	}
 	return f
 }
+
+func TestListCommits(t *testing.T) {
+	client, data := NewDefault()
+	data.CommitMap["test/test#1"] = []scm.Commit{{Sha: "a"}, {Sha: "b"}, {Sha: "c"}}
+
+	commits, _, err := client.PullRequests.ListCommits(context.Background(), "test/test", 1, scm.ListOptions{Page: 2, Size: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 1 || commits[0].Sha != "c" {
+		t.Errorf("ListCommits() got %#v, want commit c", commits)
+	}
+
+	commits, _, err = client.PullRequests.ListCommits(context.Background(), "test/test", 2, scm.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commits) != 0 {
+		t.Errorf("ListCommits() got %d commits, want none", len(commits))
+	}
+}
+
+func TestAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+	data.PullRequests[1] = &scm.PullRequest{Number: 1}
+
+	_, err := client.PullRequests.EnableAutoMerge(ctx, "test/test", 1, &scm.AutoMergeOptions{MergeMethod: "squash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.PullRequests[1].AutoMerge == nil || data.PullRequests[1].AutoMerge.MergeMethod != "squash" {
+		t.Errorf("EnableAutoMerge() got %#v, want squash auto-merge", data.PullRequests[1].AutoMerge)
+	}
+
+	_, err = client.PullRequests.DisableAutoMerge(ctx, "test/test", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.PullRequests[1].AutoMerge != nil {
+		t.Errorf("DisableAutoMerge() got %#v, want no auto-merge", data.PullRequests[1].AutoMerge)
+	}
+
+	if _, err := client.PullRequests.EnableAutoMerge(ctx, "test/test", 2, nil); err == nil {
+		t.Errorf("EnableAutoMerge() want error for missing pull request")
+	}
+}
+
+func TestSetDraft(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+
+	pr, _, err := client.PullRequests.Create(ctx, "test/test", &scm.PullRequestInput{Title: "WIP", Draft: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pr.Draft {
+		t.Errorf("Create() want draft pull request")
+	}
+
+	_, err = client.PullRequests.SetDraft(ctx, "test/test", pr.Number, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.PullRequests[pr.Number].Draft {
+		t.Errorf("SetDraft() want pull request ready for review")
+	}
+
+	if _, err := client.PullRequests.SetDraft(ctx, "test/test", pr.Number+1, true); err == nil {
+		t.Errorf("SetDraft() want error for missing pull request")
+	}
+}
+
+func TestCreateWithFields(t *testing.T) {
+	client, _ := NewDefault()
+
+	input := &scm.PullRequestInput{
+		Title:     "Add feature",
+		Head:      "fork:feature",
+		Base:      "master",
+		Labels:    []string{"bug"},
+		Assignees: []string{"alice"},
+		Reviewers: []string{"bob"},
+		Milestone: 2,
+	}
+	pr, _, err := client.PullRequests.Create(context.Background(), "test/test", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.Head.Ref != "feature" || pr.Head.Repo.FullName != "fork/test" {
+		t.Errorf("Create() want head fork/test:feature, got %s:%s", pr.Head.Repo.FullName, pr.Head.Ref)
+	}
+	if len(pr.Labels) != 1 || pr.Labels[0].Name != "bug" {
+		t.Errorf("Create() want label bug, got %v", pr.Labels)
+	}
+	if len(pr.Assignees) != 1 || pr.Assignees[0].Login != "alice" {
+		t.Errorf("Create() want assignee alice, got %v", pr.Assignees)
+	}
+	if len(pr.Reviewers) != 1 || pr.Reviewers[0].Login != "bob" {
+		t.Errorf("Create() want reviewer bob, got %v", pr.Reviewers)
+	}
+	if pr.Milestone.Number != 2 {
+		t.Errorf("Create() want milestone 2, got %d", pr.Milestone.Number)
+	}
+}
+
+func TestListReviewers(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+
+	pr, _, err := client.PullRequests.Create(ctx, "test/test", &scm.PullRequestInput{Title: "Add feature", Reviewers: []string{"alice", "bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data.Reviews[pr.Number] = []*scm.Review{
+		{Author: scm.User{Login: "alice"}, State: scm.ReviewStateApproved},
+	}
+
+	got, _, err := client.PullRequests.ListReviewers(ctx, "test/test", pr.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListReviewers() want 2 reviewers, got %d", len(got))
+	}
+	if got[0].User.Login != "alice" || got[0].State != scm.ReviewStateApproved {
+		t.Errorf("ListReviewers() want alice approved, got %s %s", got[0].User.Login, got[0].State)
+	}
+	if got[1].User.Login != "bob" || got[1].State != scm.ReviewStatePending {
+		t.Errorf("ListReviewers() want bob pending, got %s %s", got[1].User.Login, got[1].State)
+	}
+}
@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// reactionService records the reactions to the issues and pull
+// requests in IssueReactionsAdded and the reactions to the comments
+// in CommentReactionsAdded, as "org/repo#number:emoji" and
+// "org/repo#id:emoji" respectively.
+type reactionService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.IssueReactionsAdded, repo, number), nil, nil
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	f := s.data
+	f.IssueReactionsAdded = append(f.IssueReactionsAdded, fmt.Sprintf("%s#%d:%s", repo, number, emoji))
+	return &scm.Reaction{Emoji: emoji, Content: emoji.String(), User: scm.User{Login: botName}}, nil, nil
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	f := s.data
+	f.IssueReactionsAdded = deleteReaction(f.IssueReactionsAdded, fmt.Sprintf("%s#%d:%s", repo, number, emoji))
+	return nil, nil
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return listReactions(s.data.CommentReactionsAdded, repo, id), nil, nil
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	f := s.data
+	f.CommentReactionsAdded = append(f.CommentReactionsAdded, fmt.Sprintf("%s#%d:%s", repo, id, emoji))
+	return &scm.Reaction{Emoji: emoji, Content: emoji.String(), User: scm.User{Login: botName}}, nil, nil
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	f := s.data
+	f.CommentReactionsAdded = deleteReaction(f.CommentReactionsAdded, fmt.Sprintf("%s#%d:%s", repo, id, emoji))
+	return nil, nil
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueReactions(ctx, repo, number, opts)
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueCommentReactions(ctx, repo, number, id, opts)
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+func listReactions(added []string, repo string, id int) []*scm.Reaction {
+	re := regexp.MustCompile(fmt.Sprintf(`^%s#%d:(.*)$`, regexp.QuoteMeta(repo), id))
+	reactions := []*scm.Reaction{}
+	for _, v := range added {
+		if groups := re.FindStringSubmatch(v); groups != nil {
+			reactions = append(reactions, &scm.Reaction{
+				Emoji:   scm.ToEmoji(groups[1]),
+				Content: groups[1],
+				User:    scm.User{Login: botName},
+			})
+		}
+	}
+	return reactions
+}
+
+func deleteReaction(added []string, reaction string) []string {
+	remaining := []string{}
+	for _, v := range added {
+		if v != reaction {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
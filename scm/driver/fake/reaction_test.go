@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestReactions(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+
+	if _, _, err := client.Reactions.CreateIssueReaction(ctx, "org/repo", 1, scm.EmojiHeart); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Reactions.CreatePullRequestCommentReaction(ctx, "org/repo", 1, 42, scm.EmojiThumbsUp); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := data.IssueReactionsAdded, []string{"org/repo#1:heart"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("IssueReactionsAdded = %v, want %v", got, want)
+	}
+	if got, want := data.CommentReactionsAdded, []string{"org/repo#42:+1"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("CommentReactionsAdded = %v, want %v", got, want)
+	}
+
+	reactions, _, err := client.Reactions.ListPullRequestReactions(ctx, "org/repo", 1, scm.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reactions) != 1 || reactions[0].Emoji != scm.EmojiHeart {
+		t.Errorf("unexpected reactions %v", reactions)
+	}
+
+	if _, err := client.Reactions.DeleteIssueReaction(ctx, "org/repo", 1, scm.EmojiHeart); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.IssueReactionsAdded) != 0 {
+		t.Errorf("IssueReactionsAdded = %v, want none", data.IssueReactionsAdded)
+	}
+}
@@ -129,6 +129,16 @@ func (s *repositoryService) Find(ctx context.Context, fullName string) (*scm.Rep
 	return nil, &scm.Response{Status: 404}, scm.ErrNotFound
 }
 
+func (s *repositoryService) SetDefaultBranch(ctx context.Context, fullName, branch string) (*scm.Response, error) {
+	for _, repo := range s.data.Repositories {
+		if repo.FullName == fullName {
+			repo.Branch = branch
+			return &scm.Response{Status: 200}, nil
+		}
+	}
+	return &scm.Response{Status: 404}, scm.ErrNotFound
+}
+
 func (s *repositoryService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Repository, *scm.Response, error) {
 	return s.data.Repositories, nil, nil
 }
@@ -142,6 +152,41 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	return la, nil, nil
 }
 
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	f := s.data
+	for _, l := range f.RepoLabelsExisting {
+		if l == input.Name {
+			return nil, nil, fmt.Errorf("label %s already exists", input.Name)
+		}
+	}
+	f.RepoLabelsExisting = append(f.RepoLabelsExisting, input.Name)
+	return &scm.Label{Name: input.Name, Color: input.Color, Description: input.Description}, nil, nil
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	f := s.data
+	for i, l := range f.RepoLabelsExisting {
+		if l == name {
+			if input.Name != "" {
+				f.RepoLabelsExisting[i] = input.Name
+			}
+			return &scm.Label{Name: f.RepoLabelsExisting[i], Color: input.Color, Description: input.Description}, nil, nil
+		}
+	}
+	return nil, nil, scm.ErrNotFound
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	f := s.data
+	for i, l := range f.RepoLabelsExisting {
+		if l == name {
+			f.RepoLabelsExisting = append(f.RepoLabelsExisting[:i], f.RepoLabelsExisting[i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, scm.ErrNotFound
+}
+
 func (s *repositoryService) ListStatus(ctx context.Context, repo string, ref string, opt scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
 	f := s.data
 	result := make([]*scm.Status, 0, len(f.Statuses))
@@ -0,0 +1,57 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// searchService searches the Repositories and the PullRequests
+// by name and title. The code and commits cannot be searched.
+type searchService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCode, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCommit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.Repository, *scm.Response, error) {
+	var repos []*scm.Repository
+	for _, repo := range s.data.Repositories {
+		if opts.Org != "" && repo.Namespace != opts.Org {
+			continue
+		}
+		if strings.Contains(repo.Name, opts.Query) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil, nil
+}
+
+func (s *searchService) PullRequests(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	var prs []*scm.PullRequest
+	for _, pr := range s.data.PullRequests {
+		repo := pr.Repository()
+		if opts.Repo != "" && repo.FullName != opts.Repo {
+			continue
+		}
+		if opts.Org != "" && repo.Namespace != opts.Org {
+			continue
+		}
+		if strings.Contains(pr.Title, opts.Query) {
+			prs = append(prs, pr)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		return prs[i].Number < prs[j].Number
+	})
+	return prs, nil, nil
+}
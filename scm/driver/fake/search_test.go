@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	client, data := NewDefault()
+	data.Repositories = []*scm.Repository{
+		{Namespace: "org", Name: "module-a", FullName: "org/module-a"},
+		{Namespace: "other", Name: "module-b", FullName: "other/module-b"},
+	}
+	data.PullRequests[2] = &scm.PullRequest{
+		Number: 2,
+		Title:  "Bump the deprecated module",
+		Base:   scm.PullRequestBranch{Repo: *data.Repositories[0]},
+	}
+	data.PullRequests[1] = &scm.PullRequest{
+		Number: 1,
+		Title:  "Fix the build",
+		Base:   scm.PullRequestBranch{Repo: *data.Repositories[0]},
+	}
+
+	repos, _, err := client.Search.Repositories(ctx, scm.SearchQueryOptions{Query: "module", Org: "org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(repos) != 1 || repos[0].FullName != "org/module-a" {
+		t.Errorf("unexpected repositories %v", repos)
+	}
+
+	prs, _, err := client.Search.PullRequests(ctx, scm.SearchQueryOptions{Query: "deprecated", Repo: "org/module-a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prs) != 1 || prs[0].Number != 2 {
+		t.Errorf("unexpected pull requests %v", prs)
+	}
+
+	if _, _, err := client.Search.Code(ctx, scm.SearchQueryOptions{Query: "module"}); err != scm.ErrNotSupported {
+		t.Errorf("want ErrNotSupported, got %v", err)
+	}
+}
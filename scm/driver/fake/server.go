@@ -0,0 +1,16 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+	data   *Data
+}
+
+func (s *serverService) Find(context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	return &scm.ServerInfo{Product: scm.DriverFake.String()}, nil, nil
+}
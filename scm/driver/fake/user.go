@@ -23,6 +23,10 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return &s.data.CurrentUser, nil, nil
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	return &scm.TokenInfo{User: &s.data.CurrentUser}, nil, nil
+}
+
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
 	return s.data.CurrentUser.Email, nil, nil
 }
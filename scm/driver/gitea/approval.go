@@ -0,0 +1,105 @@
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+// ListRules returns the required approvals of the branch protection
+// of the pull request base branch.
+func (s *approvalService) ListRules(ctx context.Context, repo string, number int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	pr, resp, err := s.client.GiteaClient.GetPullRequest(namespace, name, int64(number))
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	branch, resp, err := s.client.GiteaClient.GetRepoBranch(namespace, name, pr.Base.Ref)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	rules := []*scm.ApprovalRule{}
+	if branch.Protected && branch.RequiredApprovals > 0 {
+		rules = append(rules, &scm.ApprovalRule{
+			Name:     "Required approvals",
+			Required: int(branch.RequiredApprovals),
+		})
+	}
+	return rules, toSCMResponse(resp), nil
+}
+
+// FindStatus evaluates the required approvals against the latest
+// official review of each reviewer, like Gitea does.
+func (s *approvalService) FindStatus(ctx context.Context, repo string, number int) (*scm.ApprovalStatus, *scm.Response, error) {
+	rules, res, err := s.ListRules(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	reviews, resp, err := s.client.GiteaClient.ListPullReviews(namespace, name, int64(number), gitea.ListPullReviewsOptions{})
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return scm.EvaluateApprovals(rules, approvedBy(reviews)), toSCMResponse(resp), nil
+}
+
+func (s *approvalService) Approve(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	_, res, err := s.client.Reviews.Create(ctx, repo, number, &scm.ReviewInput{Event: "APPROVED"})
+	return res, err
+}
+
+// Unapprove dismisses the approving reviews of the authenticated
+// user, which is only available since Gitea 1.14.
+func (s *approvalService) Unapprove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return res, err
+	}
+	reviews, res, err := s.client.Reviews.List(ctx, repo, number, scm.ListOptions{})
+	if err != nil {
+		return res, err
+	}
+	for _, review := range reviews {
+		if review.State != string(gitea.ReviewStateApproved) || review.Author.Login != user.Login {
+			continue
+		}
+		_, res, err = s.client.Reviews.Dismiss(ctx, repo, number, review.ID, "Approval revoked")
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// approvedBy returns the users whose latest official review
+// approves the pull request, in the order of the reviews.
+func approvedBy(reviews []*gitea.PullReview) []scm.User {
+	latest := map[int64]*gitea.PullReview{}
+	for _, review := range reviews {
+		if review.Reviewer == nil {
+			continue
+		}
+		switch review.State {
+		case gitea.ReviewStateApproved, gitea.ReviewStateRequestChanges:
+			latest[review.Reviewer.ID] = review
+		}
+	}
+	users := []scm.User{}
+	for _, review := range reviews {
+		if review.Reviewer == nil || latest[review.Reviewer.ID] != review {
+			continue
+		}
+		if review.State != gitea.ReviewStateApproved || !review.Official || review.Dismissed {
+			continue
+		}
+		if user := convertUser(review.Reviewer); user != nil {
+			users = append(users, *user)
+		}
+	}
+	return users
+}
@@ -0,0 +1,98 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestApprovalListRules(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branches/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protected.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Approvals.ListRules(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ApprovalRule{{Name: "Required approvals", Required: 2}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestApprovalFindStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branches/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_protected.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews_approved.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Approvals.FindStatus(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	jcitizen := scm.User{
+		ID:     6641,
+		Login:  "jcitizen",
+		Email:  "jcitizen@example.com",
+		Avatar: "https://secure.gravatar.com/avatar/66f07ff48e6a9cb393de7a34e03bb52a?d=identicon",
+	}
+	rule := &scm.ApprovalRule{
+		Name:       "Required approvals",
+		Required:   2,
+		ApprovedBy: []scm.User{jcitizen},
+	}
+	want := &scm.ApprovalStatus{
+		ApprovedBy: []scm.User{jcitizen},
+		Rules:      []*scm.ApprovalRule{rule},
+		Missing:    []*scm.ApprovalRule{rule},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
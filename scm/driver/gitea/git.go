@@ -6,8 +6,12 @@ package gitea
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -40,6 +44,30 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return nil, nil, scm.ErrNotSupported
 }
 
+// CreateTag creates an annotated tag with the tags api, which
+// is available since Gitea 1.15. A tag without a message would be
+// created as a lightweight tag, so the message is required.
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.15") || input.Tagger != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	if input.Message == "" {
+		return nil, nil, scm.ErrTagMessageRequired
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags", repo)
+	in := &tagInput{
+		TagName: input.Name,
+		Message: input.Message,
+		Target:  input.Target,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertAnnotatedTag(out), res, nil
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	if strings.HasPrefix(ref, "heads/") {
@@ -59,16 +87,71 @@ func (s *gitService) FindBranch(ctx context.Context, repo, branchName string) (*
 	return convertBranch(out), toSCMResponse(resp), err
 }
 
+// CreateBranch creates a branch with the branches api, which is
+// available since Gitea 1.13. The ref must be a branch name.
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.13") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/branches", repo)
+	in := &branchInput{
+		NewBranchName: input.Name,
+		OldBranchName: scm.TrimRef(input.Ref),
+	}
+	out := new(gitea.Branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBranch(out), res, nil
+}
+
+// RenameBranch renames a branch, which is available since
+// Gitea 1.23.
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.23") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/branches/%s", repo, branchName)
+	in := map[string]string{"name": name}
+	res, err := s.client.do(ctx, "PATCH", path, in, nil)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.FindBranch(ctx, repo, name)
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
-	namespace, name := scm.Split(repo)
-	out, resp, err := s.client.GiteaClient.GetSingleCommit(namespace, name, ref)
-	return convertCommit(out), toSCMResponse(resp), err
+	// the sdk does not decode the stats and the signature
+	// verification of the commit.
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s", repo, ref)
+	out := new(commitDetail)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertCommitDetail(out), res, err
 }
 
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// FindTagObject finds a tag with the tags api, which is available
+// since Gitea 1.15.
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.15") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/tags/%s", repo, url.PathEscape(name))
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertAnnotatedTag(out), res, nil
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	out, resp, err := s.client.GiteaClient.ListRepoBranches(namespace, name, gitea.ListRepoBranchesOptions{ListOptions: toGiteaListOptions(opts)})
@@ -78,6 +161,14 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 
+	if opts.Author != "" || opts.FirstParent {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// the time window filters require gitea 1.22 or newer.
+	if (!opts.Since.IsZero() || !opts.Until.IsZero()) && !s.client.serverAtLeast(ctx, "1.22") {
+		return nil, nil, scm.ErrNotSupported
+	}
+
 	listOpts := gitea.ListCommitOptions{
 		ListOptions: gitea.ListOptions{
 			Page:     opts.Page,
@@ -85,8 +176,21 @@ func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.Comm
 		},
 		SHA: opts.Sha,
 	}
-	out, resp, err := s.client.GiteaClient.ListRepoCommits(namespace, name, listOpts)
-	return convertCommitList(out), toSCMResponse(resp), err
+	// the sdk does not support the path and time filters.
+	params, _ := url.ParseQuery(listOpts.QueryEncode())
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/%s/commits?%s", namespace, name, params.Encode())
+	out := []*commitDetail{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitDetailList(out), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -104,6 +208,132 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetBlob(namespace, name, sha)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	content, err := base64.StdEncoding.DecodeString(out.Content)
+	return &scm.Blob{Sha: out.SHA, Size: out.Size, Content: content}, toSCMResponse(resp), err
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetTrees(namespace, name, sha, recursive)
+	return convertTree(out), toSCMResponse(resp), err
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CommitFiles atomically commits the changes of many files with the
+// change files api, which is available since Gitea 1.20. The api
+// cannot set the file mode, so executable files are not supported.
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	for _, file := range input.Files {
+		if file.Executable {
+			return nil, nil, scm.ErrNotSupported
+		}
+	}
+	if !s.client.serverAtLeast(ctx, "1.20") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	in := &changeFilesInput{
+		Branch:  input.Branch,
+		Message: input.Message,
+	}
+	if input.Author != nil {
+		in.Author = &identity{Name: input.Author.Name, Email: input.Author.Email}
+	}
+	if input.BaseBranch != "" {
+		_, resp, err := s.client.GiteaClient.GetRepoBranch(namespace, name, input.Branch)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return nil, toSCMResponse(resp), err
+		}
+		if err != nil {
+			in.Branch, in.NewBranch = input.BaseBranch, input.Branch
+		}
+	}
+	for _, file := range input.Files {
+		change := &changeFile{Path: file.Path}
+		switch file.Action {
+		case scm.FileActionCreate:
+			change.Operation = "create"
+		case scm.FileActionDelete:
+			change.Operation = "delete"
+		default:
+			change.Operation = "update"
+		}
+		if file.Action == scm.FileActionMove {
+			change.FromPath = file.PreviousPath
+		}
+		if file.Action != scm.FileActionCreate {
+			// updates and deletes must include the sha of the file.
+			from := file.Path
+			if change.FromPath != "" {
+				from = change.FromPath
+			}
+			out, resp, err := s.client.GiteaClient.GetContents(namespace, name, in.Branch, from)
+			if err != nil {
+				return nil, toSCMResponse(resp), err
+			}
+			change.Sha = out.SHA
+			if file.Action == scm.FileActionMove && file.Content == nil && out.Content != nil {
+				change.Content = *out.Content
+			}
+		}
+		if file.Action != scm.FileActionDelete && change.Content == "" {
+			change.Content = base64.StdEncoding.EncodeToString(file.Content)
+		}
+		in.Files = append(in.Files, change)
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/%s/contents", namespace, name)
+	out := new(filesResponse)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertFileCommit(&out.Commit), res, err
+}
+
+// MergeBranch merges the branch of the upstream repository into the
+// same branch of a fork, which is available since Gitea 1.23. The api
+// cannot merge arbitrary branches, so the head must be empty or the
+// same as the base.
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	if input.Head != "" && input.Head != input.Base {
+		return nil, nil, scm.ErrNotSupported
+	}
+	if !s.client.serverAtLeast(ctx, "1.23") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/merge-upstream", repo)
+	in := map[string]string{"branch": input.Base}
+	out := new(struct {
+		MergeType string `json:"merge_type"`
+	})
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if res != nil && res.Status == http.StatusConflict {
+		return nil, res, scm.MergeConflict{Message: fmt.Sprintf("cannot merge the upstream %s branch", input.Base)}
+	}
+	if err != nil || out.MergeType == "" {
+		return nil, res, err
+	}
+	return s.FindCommit(ctx, repo, input.Base)
+}
+
 //
 // native data structures
 //
@@ -120,18 +350,190 @@ type (
 		Timestamp time.Time `json:"timestamp"`
 	}
 
+	// gitea commit object with the fields the sdk does
+	// not decode.
+	commitDetail struct {
+		gitea.Commit
+		Stats        *commitStats
+		Verification *verification
+	}
+
+	// gitea commit stats.
+	commitStats struct {
+		Total     int `json:"total"`
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+	}
+
+	// gitea commit verification.
+	verification struct {
+		Verified  bool       `json:"verified"`
+		Reason    string     `json:"reason"`
+		Signature string     `json:"signature"`
+		Signer    *signature `json:"signer"`
+	}
+
 	// gitea signature object.
 	signature struct {
 		Name     string `json:"name"`
 		Email    string `json:"email"`
 		Username string `json:"username"`
 	}
+
+	// gitea tag object.
+	tag struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+		ID      string `json:"id"`
+		Commit  struct {
+			Sha string `json:"sha"`
+		} `json:"commit"`
+	}
+
+	// gitea create tag input.
+	tagInput struct {
+		TagName string `json:"tag_name"`
+		Message string `json:"message,omitempty"`
+		Target  string `json:"target,omitempty"`
+	}
+
+	// gitea create branch input.
+	branchInput struct {
+		NewBranchName string `json:"new_branch_name"`
+		OldBranchName string `json:"old_branch_name,omitempty"`
+	}
+
+	// gitea change files input.
+	changeFilesInput struct {
+		Branch    string        `json:"branch"`
+		NewBranch string        `json:"new_branch,omitempty"`
+		Message   string        `json:"message"`
+		Author    *identity     `json:"author,omitempty"`
+		Files     []*changeFile `json:"files"`
+	}
+
+	// gitea file change.
+	changeFile struct {
+		Operation string `json:"operation"`
+		Path      string `json:"path"`
+		FromPath  string `json:"from_path,omitempty"`
+		Content   string `json:"content,omitempty"`
+		Sha       string `json:"sha,omitempty"`
+	}
+
+	// gitea identity object.
+	identity struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	// gitea change files response.
+	filesResponse struct {
+		Commit fileCommit `json:"commit"`
+	}
+
+	// gitea commit object of a file change.
+	fileCommit struct {
+		Sha     string `json:"sha"`
+		URL     string `json:"html_url"`
+		Message string `json:"message"`
+		Author  struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"author"`
+		Committer struct {
+			Name  string    `json:"name"`
+			Email string    `json:"email"`
+			Date  time.Time `json:"date"`
+		} `json:"committer"`
+		Tree struct {
+			Sha string `json:"sha"`
+			URL string `json:"url"`
+		} `json:"tree"`
+	}
 )
 
+// UnmarshalJSON decodes the sdk commit and the fields
+// the sdk does not support.
+func (c *commitDetail) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.Commit); err != nil {
+		return err
+	}
+	var extra struct {
+		Stats  *commitStats `json:"stats"`
+		Commit struct {
+			Verification *verification `json:"verification"`
+		} `json:"commit"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	c.Stats = extra.Stats
+	c.Verification = extra.Commit.Verification
+	return nil
+}
+
 //
 // native data structure conversion
 //
 
+func convertAnnotatedTag(src *tag) *scm.Tag {
+	dst := &scm.Tag{
+		Name:       src.Name,
+		Message:    src.Message,
+		Target:     src.Commit.Sha,
+		TargetType: "commit",
+	}
+	// the id of a lightweight tag is the commit itself.
+	if src.ID != src.Commit.Sha {
+		dst.Sha = src.ID
+	}
+	return dst
+}
+
+func convertFileCommit(src *fileCommit) *scm.Commit {
+	return &scm.Commit{
+		Sha:     src.Sha,
+		Message: src.Message,
+		Link:    src.URL,
+		Tree: scm.CommitTree{
+			Sha:  src.Tree.Sha,
+			Link: src.Tree.URL,
+		},
+		Author: scm.Signature{
+			Name:  src.Author.Name,
+			Email: src.Author.Email,
+			Date:  src.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  src.Committer.Name,
+			Email: src.Committer.Email,
+			Date:  src.Committer.Date,
+		},
+	}
+}
+
+func convertTree(src *gitea.GitTreeResponse) *scm.Tree {
+	if src == nil {
+		return nil
+	}
+	dst := &scm.Tree{
+		Sha:       src.SHA,
+		Truncated: src.Truncated,
+	}
+	for _, v := range src.Entries {
+		dst.Entries = append(dst.Entries, &scm.TreeEntry{
+			Path: v.Path,
+			Mode: v.Mode,
+			Type: v.Type,
+			Sha:  v.SHA,
+			Size: v.Size,
+		})
+	}
+	return dst
+}
+
 func convertBranchList(src []*gitea.Branch) []*scm.Reference {
 	dst := []*scm.Reference{}
 	for _, v := range src {
@@ -145,9 +547,11 @@ func convertBranch(src *gitea.Branch) *scm.Reference {
 		return nil
 	}
 	return &scm.Reference{
-		Name: scm.TrimRef(src.Name),
-		Path: scm.ExpandRef(src.Name, "refs/heads/"),
-		Sha:  src.Commit.ID,
+		Name:      scm.TrimRef(src.Name),
+		Path:      scm.ExpandRef(src.Name, "refs/heads/"),
+		Sha:       src.Commit.ID,
+		Protected: src.Protected,
+		Date:      src.Commit.Timestamp,
 	}
 }
 
@@ -182,13 +586,53 @@ func convertCommit(src *gitea.Commit) *scm.Commit {
 	if src == nil || src.RepoCommit == nil {
 		return nil
 	}
-	return &scm.Commit{
+	dst := &scm.Commit{
 		Sha:       src.SHA,
 		Link:      src.URL,
 		Message:   src.RepoCommit.Message,
 		Author:    convertUserSignature(src.Author),
 		Committer: convertUserSignature(src.Committer),
 	}
+	for _, v := range src.Parents {
+		dst.Parents = append(dst.Parents, v.SHA)
+	}
+	for _, v := range src.Files {
+		dst.Files = append(dst.Files, &scm.Change{Path: v.Filename})
+	}
+	return dst
+}
+
+func convertCommitDetailList(src []*commitDetail) []*scm.Commit {
+	dst := []*scm.Commit{}
+	for _, v := range src {
+		dst = append(dst, convertCommitDetail(v))
+	}
+	return dst
+}
+
+func convertCommitDetail(src *commitDetail) *scm.Commit {
+	dst := convertCommit(&src.Commit)
+	if dst == nil {
+		return nil
+	}
+	if src.Stats != nil {
+		dst.Stats = &scm.CommitStats{
+			Additions: src.Stats.Additions,
+			Deletions: src.Stats.Deletions,
+			Total:     src.Stats.Total,
+		}
+	}
+	if v := src.Verification; v != nil {
+		dst.Verification = &scm.Verification{
+			Verified:  v.Verified,
+			Reason:    v.Reason,
+			Signature: v.Signature,
+		}
+		if v.Signer != nil {
+			dst.Verification.Signer = v.Signer.Email
+		}
+	}
+	return dst
 }
 
 func convertUserSignature(src *gitea.User) scm.Signature {
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -80,6 +81,60 @@ func TestCommitList(t *testing.T) {
 	}
 }
 
+func TestCommitListFilters(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_22.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/commits").
+		MatchParam("path", "README.md").
+		MatchParam("since", "2018-09-01T00:00:00Z").
+		MatchParam("until", "2018-10-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("https://try.gitea.io")
+	opts := scm.CommitListOptions{
+		Path:  "README.md",
+		Since: time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	got, _, err := client.Git.ListCommits(context.Background(), "go-gitea/gitea", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 {
+		t.Errorf("Want 1 commit, got %d", len(got))
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestCommitListFiltersNotSupported(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{Author: "lunny"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for author filter")
+	}
+	_, _, err = client.Git.ListCommits(context.Background(), "go-gitea/gitea", scm.CommitListOptions{Since: time.Now()})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error for time window on old server")
+	}
+}
+
 func TestChangeList(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
 	_, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
@@ -157,6 +212,170 @@ func TestBranchList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestBranchCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branches").
+		JSON(map[string]string{
+			"new_branch_name": "master",
+			"old_branch_name": "develop",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	client, _ := New("https://try.gitea.io")
+	input := &scm.BranchInput{Name: "master", Ref: "develop"}
+	got, _, err := client.Git.CreateBranch(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestBranchRename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_23.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/branches/main").
+		JSON(map[string]string{"name": "master"}).
+		Reply(204)
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/branches/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.RenameBranch(context.Background(), "go-gitea/gitea", "main", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestBranchRenameOldServer(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.RenameBranch(context.Background(), "go-gitea/gitea", "main", "master")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMergeBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_23.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/gitea/gitea/merge-upstream").
+		JSON(map[string]string{"branch": "master"}).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"merge_type":"fast-forward"}`)
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/gitea/gitea/git/commits/master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.MergeBranch(context.Background(), "gitea/gitea", &scm.MergeInput{Base: "master"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMergeBranchConflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_23.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/gitea/gitea/merge-upstream").
+		Reply(409).
+		Type("application/json").
+		BodyString(`{"message":"merge conflict"}`)
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.MergeBranch(context.Background(), "gitea/gitea", &scm.MergeInput{Base: "master"})
+	if !scm.IsMergeConflict(err) {
+		t.Errorf("Want merge conflict error, got %v", err)
+	}
+}
+
+func TestMergeBranchNotSupported(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	input := &scm.MergeInput{Base: "master", Head: "feature"}
+	_, _, err := client.Git.MergeBranch(context.Background(), "gitea/gitea", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 //
 // tag sub-tests
 //
@@ -198,3 +417,196 @@ func TestTagList(t *testing.T) {
 
 	t.Run("Page", testPage(res))
 }
+
+func TestTagCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/tags").
+		JSON(map[string]string{
+			"tag_name": "v1.0.0",
+			"message":  "Release v1.0.0\n",
+			"target":   "c43399cad8766ee521b873a32c1652407c5a4630",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/tag_create.json")
+
+	client, _ := New("https://try.gitea.io")
+	input := &scm.TagInput{
+		Name:    "v1.0.0",
+		Message: "Release v1.0.0\n",
+		Target:  "c43399cad8766ee521b873a32c1652407c5a4630",
+	}
+	got, _, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestTagCreateOldServer(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", &scm.TagInput{Name: "v1.0.0"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestTagCreateWithoutMessage(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.CreateTag(context.Background(), "go-gitea/gitea", &scm.TagInput{Name: "v1.0.0"})
+	if err != scm.ErrTagMessageRequired {
+		t.Errorf("Want ErrTagMessageRequired, got %v", err)
+	}
+}
+
+func TestTagFindObject(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_create.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.FindTagObject(context.Background(), "go-gitea/gitea", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+//
+// multi-file commit sub-tests
+//
+
+func TestCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/contents/.gitignore").
+		MatchParam("ref", "master").
+		Reply(200).
+		Type("application/json").
+		File("testdata/content_find.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents").
+		JSON(map[string]interface{}{
+			"branch":  "master",
+			"message": "update files\n",
+			"files": []map[string]interface{}{
+				{"operation": "create", "path": "README.md", "content": "SGVsbG8gV29ybGQK"},
+				{"operation": "delete", "path": ".gitignore", "sha": "8d8863546a1b476ec51d4a9f150a031264d35eef"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/files_response.json")
+
+	client, _ := New("https://try.gitea.io")
+	input := &scm.CommitFilesInput{
+		Branch:  "master",
+		Message: "update files\n",
+		Files: []*scm.FileChange{
+			{Action: scm.FileActionCreate, Path: "README.md", Content: []byte("Hello World\n")},
+			{Action: scm.FileActionDelete, Path: ".gitignore"},
+		},
+	}
+	got, _, err := client.Git.CommitFiles(context.Background(), "go-gitea/gitea", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/files_response.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestCommitFilesOldServer(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Git.CommitFiles(context.Background(), "go-gitea/gitea", &scm.CommitFilesInput{Branch: "master"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestCommitFilesExecutable(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	input := &scm.CommitFilesInput{
+		Branch: "master",
+		Files:  []*scm.FileChange{{Action: scm.FileActionCreate, Path: "bin/run", Executable: true}},
+	}
+	_, _, err := client.Git.CommitFiles(context.Background(), "go-gitea/gitea", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/transport"
 )
 
 // NewWebHookService creates a new instance of the webhook service without the rest of the client
@@ -44,17 +45,31 @@ func NewWithToken(uri string, token string) (*scm.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if token != "" {
+		// authenticate the requests which are not made with the sdk.
+		client.Client.Client = &http.Client{
+			Transport: &transport.Authorization{
+				Scheme:      "token",
+				Credentials: token,
+			},
+		}
+	}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitea
 	client.Contents = &contentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.MergeQueues = &mergeQueueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Approvals = &approvalService{client}
+	client.Reactions = &reactionService{client}
+	client.Search = &searchService{client}
+	client.Server = &serverService{client}
 	client.Releases = &releaseService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
@@ -76,17 +91,28 @@ func NewWithBasicAuth(uri string, user, password string) (*scm.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	client.Client.Client = &http.Client{
+		Transport: &transport.BasicAuth{
+			Username: user,
+			Password: password,
+		},
+	}
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverGitea
 	client.Contents = &contentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.MergeQueues = &mergeQueueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Approvals = &approvalService{client}
+	client.Reactions = &reactionService{client}
+	client.Search = &searchService{client}
+	client.Server = &serverService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -99,6 +125,13 @@ type wrapper struct {
 	GiteaClient *gitea.Client
 }
 
+// serverAtLeast returns true if the server is known to run at least
+// the given version.
+func (c *wrapper) serverAtLeast(ctx context.Context, version string) bool {
+	info, err := c.ServerInfo(ctx)
+	return err == nil && info.AtLeast(version)
+}
+
 // do wraps the Client.Do function by creating the Request and
 // unmarshalling the response.
 func (c *wrapper) do(ctx context.Context, method, path string, in, out interface{}) (*scm.Response, error) {
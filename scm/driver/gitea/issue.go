@@ -7,6 +7,10 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
@@ -107,6 +111,23 @@ func (s *issueService) lookupLabel(ctx context.Context, repo string, lbl string)
 	return labelID, res, nil
 }
 
+// lookupLabels returns the identifiers of the repository labels
+// with the given names, or ErrNotFound if one of them is missing.
+func (s *issueService) lookupLabels(ctx context.Context, repo string, lbls []string) ([]int64, *scm.Response, error) {
+	var ids []int64
+	for _, lbl := range lbls {
+		labelID, res, err := s.lookupLabel(ctx, repo, lbl)
+		if err != nil {
+			return nil, res, err
+		}
+		if labelID == -1 {
+			return nil, res, scm.ErrNotFound
+		}
+		ids = append(ids, labelID)
+	}
+	return ids, nil, nil
+}
+
 func (s *issueService) AddLabel(ctx context.Context, repo string, number int, lbl string) (*scm.Response, error) {
 	labelID, res, err := s.lookupLabel(ctx, repo, lbl)
 	if err != nil {
@@ -179,21 +200,53 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	namespace, name := scm.Split(repo)
-	in := gitea.ListIssueOption{
-		ListOptions: gitea.ListOptions{
-			Page:     opts.Page,
-			PageSize: opts.Size,
-		},
-		Type: gitea.IssueTypeIssue,
+	// the api cannot sort issues.
+	if opts.Sort != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// the filters are not supported by the sdk, so the request is
+	// made directly.
+	path := fmt.Sprintf("api/v1/repos/%s/issues?%s", repo, encodeIssueListOptions(opts))
+	out := []*gitea.Issue{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueList(out), res, err
+}
+
+func encodeIssueListOptions(opts scm.IssueListOptions) string {
+	params := url.Values{}
+	params.Set("type", string(gitea.IssueTypeIssue))
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
 	}
 	if opts.Open && !opts.Closed {
-		in.State = gitea.StateOpen
+		params.Set("state", string(gitea.StateOpen))
 	} else if opts.Closed && !opts.Open {
-		in.State = gitea.StateClosed
+		params.Set("state", string(gitea.StateClosed))
+	} else if opts.Open && opts.Closed {
+		params.Set("state", string(gitea.StateAll))
+	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Milestone != 0 {
+		params.Set("milestones", strconv.Itoa(opts.Milestone))
+	}
+	if opts.Assignee != "" {
+		params.Set("assigned_by", opts.Assignee)
 	}
-	out, resp, err := s.client.GiteaClient.ListRepoIssues(namespace, name, in)
-	return convertIssueList(out), toSCMResponse(resp), err
+	if opts.Author != "" {
+		params.Set("created_by", opts.Author)
+	}
+	if opts.Mentioned != "" {
+		params.Set("mentioned_by", opts.Mentioned)
+	}
+	if opts.UpdatedAfter != nil {
+		params.Set("since", opts.UpdatedAfter.UTC().Format(time.RFC3339))
+	}
+	return params.Encode()
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
@@ -206,9 +259,16 @@ func (s *issueService) Create(ctx context.Context, repo string, input *scm.Issue
 	namespace, name := scm.Split(repo)
 
 	in := gitea.CreateIssueOption{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: input.Assignees,
+		Milestone: int64(input.Milestone),
 	}
+	labels, res, err := s.lookupLabels(ctx, repo, input.Labels)
+	if err != nil {
+		return nil, res, err
+	}
+	in.Labels = labels
 	out, resp, err := s.client.GiteaClient.CreateIssue(namespace, name, in)
 	return convertIssue(out), toSCMResponse(resp), err
 }
@@ -297,11 +357,14 @@ func convertIssue(from *gitea.Issue) *scm.Issue {
 		Body:      from.Body,
 		Link:      from.URL,
 		Closed:    from.State == gitea.StateClosed,
-		Labels:    convertIssueLabels(from),
+		Labels:    convertLabels(from.Labels),
 		Author:    *convertUser(from.Poster),
 		Assignees: convertUsers(from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+		DueDate:   from.Deadline,
 		Created:   from.Created,
 		Updated:   from.Updated,
+		ClosedAt:  from.Closed,
 	}
 }
 
@@ -326,24 +389,23 @@ func convertIssueComment(from *gitea.Comment) *scm.Comment {
 	}
 }
 
-func convertIssueLabels(from *gitea.Issue) []string {
-	var labels []string
-	for _, label := range from.Labels {
-		labels = append(labels, label.Name)
-	}
-	return labels
-}
-
 func convertLabels(from []*gitea.Label) []*scm.Label {
 	var labels []*scm.Label
 	for _, label := range from {
-		labels = append(labels, &scm.Label{
-			ID:          label.ID,
-			Name:        label.Name,
-			Description: label.Description,
-			URL:         label.URL,
-			Color:       label.Color,
-		})
+		labels = append(labels, convertLabel(label))
 	}
 	return labels
 }
+
+func convertLabel(from *gitea.Label) *scm.Label {
+	if from == nil {
+		return nil
+	}
+	return &scm.Label{
+		ID:          from.ID,
+		Name:        from.Name,
+		Description: from.Description,
+		URL:         from.URL,
+		Color:       from.Color,
+	}
+}
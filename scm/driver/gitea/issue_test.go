@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
@@ -77,6 +78,45 @@ func TestIssueList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueListFilters(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues").
+		MatchParam("type", "issues").
+		MatchParam("state", "open").
+		MatchParam("labels", "kind/bug").
+		MatchParam("milestones", "3").
+		MatchParam("assigned_by", "gitea").
+		MatchParam("created_by", "janedoe").
+		MatchParam("since", "2018-09-01T00:00:00Z").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	since := time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)
+	opts := scm.IssueListOptions{
+		Open:         true,
+		Labels:       []string{"kind/bug"},
+		Milestone:    3,
+		Assignee:     "gitea",
+		Author:       "janedoe",
+		UpdatedAfter: &since,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.List(context.Background(), "go-gitea/gitea", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 {
+		t.Errorf("Want 1 issue, got %d", len(got))
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -109,6 +149,42 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCreateWithLabels(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues").
+		BodyString(`"assignees":\["octocat"\].*"milestone":3,"labels":\[2\]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Body:      "I'm having a problem with this.",
+		Labels:    []string{"feature"},
+		Assignees: []string{"octocat"},
+		Milestone: 3,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.Create(context.Background(), "go-gitea/gitea", &input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestIssueClose(t *testing.T) {
 	defer gock.Off()
 
@@ -272,6 +348,18 @@ func TestIssueCommentDelete(t *testing.T) {
 	}
 }
 
+func TestIssueListSort(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Issues.List(context.Background(), "go-gitea/gitea", scm.IssueListOptions{Sort: "created"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
 func TestIssueListLabels(t *testing.T) {
 	defer gock.Off()
 
@@ -8,12 +8,20 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/bluekeyes/go-gitdiff/gitdiff"
 	"github.com/jenkins-x/go-scm/scm"
 )
 
+// draftRe matches the default work in progress prefixes, which mark
+// a pull request as a draft.
+var draftRe = regexp.MustCompile(`(?i)^\s*(wip:|\[wip\])\s*`)
+
 type pullService struct {
 	*issueService
 }
@@ -41,6 +49,25 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), toSCMResponse(resp), err
 }
 
+// ListCommits lists the commits of a pull request, which is
+// available since Gitea 1.17.
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.17") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/commits?%s", repo, number, params.Encode())
+	out := []*commitDetail{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitDetailList(out), res, err
+}
+
 // TODO: Maybe contribute to gitea/go-sdk with .patch function?
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	// Get the patch and then parse it.
@@ -92,14 +119,108 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int, options
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	in := gitea.EditPullRequestOption{
-		Title: input.Title,
-		Body:  input.Body,
-		Base:  input.Base,
+		Title:     input.Title,
+		Body:      input.Body,
+		Base:      input.Base,
+		Milestone: int64(input.Milestone),
 	}
+	assignees, res, err := s.updateAssignees(ctx, repo, number, input)
+	if err != nil {
+		return nil, res, err
+	}
+	in.Assignees = assignees
+	labels, res, err := s.lookupLabels(ctx, repo, input.Labels)
+	if err != nil {
+		return nil, res, err
+	}
+	in.Labels = labels
 	out, resp, err := s.client.GiteaClient.EditPullRequest(namespace, name, int64(number), in)
 	return convertPullRequest(out), toSCMResponse(resp), err
 }
 
+// SetDraft adds, or removes, the WIP prefix of the pull request
+// title, which is how Gitea marks drafts.
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, number)
+	out := new(gitea.PullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	title := draftTitle(out.Title, draft)
+	if title == out.Title {
+		return res, nil
+	}
+	return s.client.do(ctx, "PATCH", path, map[string]string{"title": title}, nil)
+}
+
+// draftTitle returns the title with, or without, the WIP prefix.
+func draftTitle(title string, draft bool) string {
+	switch {
+	case draft && draftRe.MatchString(title):
+		return title
+	case draft:
+		return "WIP: " + title
+	default:
+		return draftRe.ReplaceAllString(title, "")
+	}
+}
+
+// UpdatePullRequestBranch updates the pull request branch, which is
+// available since Gitea 1.16. The api cannot check the head sha.
+func (s *pullService) UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	if opts != nil && opts.SHA != "" {
+		return nil, scm.ErrNotSupported
+	}
+	if !s.client.serverAtLeast(ctx, "1.16") {
+		return nil, scm.ErrNotSupported
+	}
+	style := "merge"
+	if opts != nil && opts.Method != "" {
+		style = opts.Method
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/update?style=%s", repo, number, style)
+	res, err := s.client.do(ctx, "POST", path, nil, nil)
+	if res != nil && res.Status == http.StatusConflict {
+		return res, scm.MergeConflict{Message: fmt.Sprintf("cannot update the branch of pull request %d", number)}
+	}
+	return res, err
+}
+
+func (s *pullService) RebasePullRequest(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return s.UpdatePullRequestBranch(ctx, repo, number, &scm.PullRequestUpdateBranchOptions{Method: "rebase"})
+}
+
+// EnableAutoMerge schedules the merge of the pull request once
+// its checks succeed, which is available since Gitea 1.17.
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, opts *scm.AutoMergeOptions) (*scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.17") {
+		return nil, scm.ErrNotSupported
+	}
+	in := &autoMergeInput{
+		Style:                  gitea.MergeStyleMerge,
+		MergeWhenChecksSucceed: true,
+	}
+	if opts != nil {
+		in.Style = convertMergeMethodToMergeStyle(opts.MergeMethod)
+		in.Title = opts.CommitTitle
+		in.Message = opts.CommitMessage
+		in.HeadCommitID = opts.SHA
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, number)
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+// DisableAutoMerge cancels the scheduled merge of the pull request,
+// which is available since Gitea 1.17.
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.17") {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, number)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	closed := gitea.StateClosed
@@ -123,15 +244,66 @@ func (s *pullService) Reopen(ctx context.Context, repo string, number int) (*scm
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	in := gitea.CreatePullRequestOption{
-		Head:  input.Head,
-		Base:  input.Base,
-		Title: input.Title,
-		Body:  input.Body,
+		Head:      input.Head,
+		Base:      input.Base,
+		Title:     input.Title,
+		Body:      input.Body,
+		Assignees: reviewAssignees(input),
+		Milestone: int64(input.Milestone),
+	}
+	if input.Draft {
+		in.Title = draftTitle(in.Title, true)
+	}
+	labels, res, err := s.lookupLabels(ctx, repo, input.Labels)
+	if err != nil {
+		return nil, res, err
 	}
+	in.Labels = labels
 	out, resp, err := s.client.GiteaClient.CreatePullRequest(namespace, name, in)
 	return convertPullRequest(out), toSCMResponse(resp), err
 }
 
+// reviewAssignees returns the assignees of the pull request input
+// together with its reviewers, since reviews are requested by
+// assigning the pull request.
+func reviewAssignees(input *scm.PullRequestInput) []string {
+	logins := append([]string(nil), input.Assignees...)
+	for _, reviewer := range input.Reviewers {
+		found := false
+		for _, login := range logins {
+			if login == reviewer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			logins = append(logins, reviewer)
+		}
+	}
+	return logins
+}
+
+// updateAssignees returns the assignees to set on Update. The
+// reviewers are added to the given assignees, or to the current
+// assignees of the pull request if none are given, so that an
+// update of the reviewers does not remove the existing ones.
+func (s *pullService) updateAssignees(ctx context.Context, repo string, number int, input *scm.PullRequestInput) ([]string, *scm.Response, error) {
+	if len(input.Reviewers) == 0 || len(input.Assignees) != 0 {
+		return reviewAssignees(input), nil, nil
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, number)
+	out := new(gitea.PullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	current := &scm.PullRequestInput{Reviewers: input.Reviewers}
+	for _, u := range out.Assignees {
+		current.Assignees = append(current.Assignees, u.UserName)
+	}
+	return reviewAssignees(current), res, nil
+}
+
 func (s *pullService) RequestReview(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
 	return s.AssignIssue(ctx, repo, number, logins)
 }
@@ -140,6 +312,42 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return s.UnassignIssue(ctx, repo, number, logins)
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.PullReviewRequestOptions{TeamReviewers: teams}
+	resp, err := s.client.GiteaClient.CreateReviewRequests(namespace, name, int64(number), in)
+	return toSCMResponse(resp), err
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.PullReviewRequestOptions{TeamReviewers: teams}
+	resp, err := s.client.GiteaClient.DeleteReviewRequests(namespace, name, int64(number), in)
+	return toSCMResponse(resp), err
+}
+
+// ListReviewers returns the reviewers of the pull request from its
+// reviews, which include the pending review requests.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	var reviews []*gitea.PullReview
+	var res *scm.Response
+	opts := gitea.ListPullReviewsOptions{ListOptions: gitea.ListOptions{Page: 1, PageSize: 50}}
+	for {
+		page, resp, err := s.client.GiteaClient.ListPullReviews(namespace, name, int64(number), opts)
+		res = toSCMResponse(resp)
+		if err != nil {
+			return nil, res, err
+		}
+		reviews = append(reviews, page...)
+		if res.Page.Next == 0 {
+			break
+		}
+		opts.Page = res.Page.Next
+	}
+	return convertReviewers(reviews), res, nil
+}
+
 //
 // native data structure conversion
 //
@@ -176,12 +384,21 @@ func convertPullRequest(src *gitea.PullRequest) *scm.PullRequest {
 		Assignees: convertUsers(src.Assignees),
 		Merged:    src.HasMerged,
 		Mergeable: src.Mergeable,
+		Draft:     draftRe.MatchString(src.Title),
 		Created:   *src.Created,
 		Updated:   *src.Updated,
 	}
 	if src.MergedCommitID != nil {
 		pr.MergeSha = *src.MergedCommitID
 	}
+	if src.MergedBy != nil {
+		pr.MergedBy = convertUser(src.MergedBy)
+	}
+	pr.MergedAt = src.Merged
+	pr.ClosedAt = src.Closed
+	if src.Head != nil && src.Base != nil && src.Head.Repository != nil && src.Base.Repository != nil {
+		pr.FromFork = src.Head.Repository.ID != src.Base.Repository.ID
+	}
 	return pr
 }
 
@@ -193,6 +410,14 @@ func convertPullRequestBranch(src *gitea.PRBranchInfo) *scm.PullRequestBranch {
 	}
 }
 
+type autoMergeInput struct {
+	Style                  gitea.MergeStyle `json:"Do"`
+	Title                  string           `json:"MergeTitleField,omitempty"`
+	Message                string           `json:"MergeMessageField,omitempty"`
+	HeadCommitID           string           `json:"head_commit_id,omitempty"`
+	MergeWhenChecksSucceed bool             `json:"merge_when_checks_succeed"`
+}
+
 func convertMergeMethodToMergeStyle(mm string) gitea.MergeStyle {
 	switch mm {
 	case "merge":
@@ -207,3 +432,60 @@ func convertMergeMethodToMergeStyle(mm string) gitea.MergeStyle {
 		return gitea.MergeStyleMerge
 	}
 }
+
+// convertReviewers returns the reviewers in order of their first
+// review, or review request. A comment does not replace an earlier
+// approval, or request for changes.
+func convertReviewers(from []*gitea.PullReview) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	byName := map[string]*scm.Reviewer{}
+	for _, r := range from {
+		var key string
+		reviewer := &scm.Reviewer{}
+		switch {
+		case r.ReviewerTeam != nil:
+			key = "team:" + r.ReviewerTeam.Name
+			reviewer.Kind = scm.ReviewerKindTeam
+			reviewer.Team = *convertTeam(r.ReviewerTeam)
+		case r.Reviewer != nil && r.Reviewer.UserName != "":
+			key = r.Reviewer.UserName
+			reviewer.Kind = scm.ReviewerKindUser
+			reviewer.User = *convertUser(r.Reviewer)
+		default:
+			continue
+		}
+		if existing, ok := byName[key]; ok {
+			reviewer = existing
+		} else {
+			byName[key] = reviewer
+			to = append(to, reviewer)
+		}
+		state := convertReviewerState(r)
+		switch {
+		case state == scm.ReviewStatePending:
+			reviewer.State = state
+		case state != scm.ReviewStateCommented || reviewer.State == "" || reviewer.State == scm.ReviewStatePending:
+			reviewer.State = state
+			reviewer.Reviewed = r.Submitted
+		default:
+			reviewer.Reviewed = r.Submitted
+		}
+	}
+	return to
+}
+
+func convertReviewerState(from *gitea.PullReview) string {
+	if from.Dismissed {
+		return scm.ReviewStateDismissed
+	}
+	switch from.State {
+	case gitea.ReviewStateApproved:
+		return scm.ReviewStateApproved
+	case gitea.ReviewStateRequestChanges:
+		return scm.ReviewStateChangesRequested
+	case gitea.ReviewStateComment:
+		return scm.ReviewStateCommented
+	default:
+		return scm.ReviewStatePending
+	}
+}
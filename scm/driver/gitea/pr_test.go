@@ -133,6 +133,55 @@ func TestPullRequestMerge(t *testing.T) {
 	}
 }
 
+func TestPullRequestUpdateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/update").
+		MatchParam("style", "rebase").
+		Reply(200)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RebasePullRequest(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestUpdateBranchConflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/update").
+		MatchParam("style", "merge").
+		Reply(409).
+		Type("application/json").
+		BodyString(`{"message":"merge conflict"}`)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "go-gitea/gitea", 1, nil)
+	if !scm.IsMergeConflict(err) {
+		t.Errorf("Want merge conflict error, got %v", err)
+	}
+}
+
 //
 // pull request change sub-tests
 //
@@ -199,3 +248,319 @@ func TestPullCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullCreateWithFields(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls").
+		BodyString(`"assignees":\["jcitizen","octocat"\],"milestone":1,"labels":\[1\]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.PullRequestInput{
+		Title:     "Add License File",
+		Head:      "octocat:feature",
+		Base:      "master",
+		Labels:    []string{"bug"},
+		Assignees: []string{"jcitizen"},
+		Reviewers: []string{"jcitizen", "octocat"},
+		Milestone: 1,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.PullRequests.Create(context.Background(), "jcitizen/my-repo", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullUpdateReviewers(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"number":1,"assignees":[{"login":"jcitizen"}]}`)
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		BodyString(`"assignees":\["jcitizen","octocat"\]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.PullRequestInput{
+		Reviewers: []string{"octocat"},
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.PullRequests.Update(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_reviewers.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.ListReviewers(context.Background(), "jcitizen/my-repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestTeamReview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_14.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/requested_reviewers").
+		BodyString(`"team_reviewers":\["reviewers"\]`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr_reviewers.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.RequestTeamReview(context.Background(), "jcitizen/my-repo", 1, []string{"reviewers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullCreateDraft(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls").
+		BodyString(`"title":"WIP: Add License File"`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	input := &scm.PullRequestInput{
+		Title: "Add License File",
+		Head:  "feature",
+		Base:  "master",
+		Draft: true,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.PullRequests.Create(context.Background(), "jcitizen/my-repo", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullSetDraft(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/jcitizen/my-repo/pulls/1").
+		JSON(map[string]string{"title": "WIP: Add License File"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.SetDraft(context.Background(), "jcitizen/my-repo", 1, true)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func Test_draftTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		draft bool
+		want  string
+	}{
+		{title: "Add License File", draft: true, want: "WIP: Add License File"},
+		{title: "[WIP] Add License File", draft: true, want: "[WIP] Add License File"},
+		{title: "wip: Add License File", draft: false, want: "Add License File"},
+		{title: "[WIP] Add License File", draft: false, want: "Add License File"},
+		{title: "Add License File", draft: false, want: "Add License File"},
+	}
+	for _, test := range tests {
+		if got := draftTitle(test.title, test.draft); got != test.want {
+			t.Errorf("Want title %q, got %q", test.want, got)
+		}
+	}
+}
+
+func TestPullRequestCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_22.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/pulls/1/commits").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.PullRequests.ListCommits(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	var want []*scm.Commit
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestCommitsNotSupported(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.PullRequests.ListCommits(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullRequestEnableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		JSON(map[string]interface{}{
+			"Do":                        "squash",
+			"MergeTitleField":           "Add a feature",
+			"merge_when_checks_succeed": true,
+		}).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	opts := &scm.AutoMergeOptions{
+		MergeMethod: "squash",
+		CommitTitle: "Add a feature",
+	}
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "go-gitea/gitea", 1, opts)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestDisableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_20.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/pulls/1/merge").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.DisableAutoMerge(context.Background(), "go-gitea/gitea", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRequestAutoMergeNotSupported(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "go-gitea/gitea", 1, nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
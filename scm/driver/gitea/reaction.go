@@ -0,0 +1,106 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// reactionService implements the reactions. Pull requests are
+// issues and the pull request comments are issue comments. Gitea
+// reactions have no identifier.
+type reactionService struct {
+	client *wrapper
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetIssueReactions(namespace, name, int64(number))
+	return convertReactionList(out), toSCMResponse(resp), err
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.PostIssueReaction(namespace, name, int64(number), emoji.String())
+	return convertReaction(out), toSCMResponse(resp), err
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteIssueReaction(namespace, name, int64(number), emoji.String())
+	return toSCMResponse(resp), err
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.GetIssueCommentReactions(namespace, name, int64(id))
+	return convertReactionList(out), toSCMResponse(resp), err
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	out, resp, err := s.client.GiteaClient.PostIssueCommentReaction(namespace, name, int64(id), emoji.String())
+	return convertReaction(out), toSCMResponse(resp), err
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteIssueCommentReaction(namespace, name, int64(id), emoji.String())
+	return toSCMResponse(resp), err
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueReactions(ctx, repo, number, opts)
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueCommentReactions(ctx, repo, number, id, opts)
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+//
+// native data structure conversion
+//
+
+func convertReactionList(src []*gitea.Reaction) []*scm.Reaction {
+	dst := []*scm.Reaction{}
+	for _, v := range src {
+		dst = append(dst, convertReaction(v))
+	}
+	return dst
+}
+
+func convertReaction(src *gitea.Reaction) *scm.Reaction {
+	if src == nil {
+		return nil
+	}
+	dst := &scm.Reaction{
+		Emoji:   scm.ToEmoji(src.Reaction),
+		Content: src.Reaction,
+		Created: src.Created,
+	}
+	if user := convertUser(src.User); user != nil {
+		dst.User = *user
+	}
+	return dst
+}
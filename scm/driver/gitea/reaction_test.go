@@ -0,0 +1,84 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestReactionListPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues/1/reactions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reactions.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reactions.ListPullRequestReactions(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reaction{}
+	raw, _ := ioutil.ReadFile("testdata/reactions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReactionCreateIssueComment(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/comments/42/reactions").
+		BodyString(`"content":"heart"`).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"user":{"id":1,"login":"gitea"},"content":"heart","created_at":"2020-06-03T12:00:00Z"}`)
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reactions.CreateIssueCommentReaction(context.Background(), "go-gitea/gitea", 1, 42, scm.EmojiHeart)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Emoji != scm.EmojiHeart || got.User.Login != "gitea" {
+		t.Errorf("Unexpected reaction %+v", got)
+	}
+}
+
+func TestReactionDeleteIssue(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/1/reactions").
+		BodyString(`"content":"heart"`).
+		Reply(200)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Reactions.DeleteIssueReaction(context.Background(), "go-gitea/gitea", 1, scm.EmojiHeart)
+	if err != nil {
+		t.Error(err)
+	}
+}
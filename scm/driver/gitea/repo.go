@@ -118,6 +118,63 @@ func (s *repositoryService) ListLabels(_ context.Context, repo string, opts scm.
 	return convertLabels(out), toSCMResponse(resp), err
 }
 
+func (s *repositoryService) CreateLabel(_ context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	in := gitea.CreateLabelOption{
+		Name:        input.Name,
+		Color:       input.Color,
+		Description: input.Description,
+	}
+	if in.Color == "" {
+		in.Color = "#00aabb"
+	}
+	out, resp, err := s.client.GiteaClient.CreateLabel(namespace, name, in)
+	return convertLabel(out), toSCMResponse(resp), err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, label string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	labelID, res, err := s.lookupLabel(ctx, repo, label)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	in := gitea.EditLabelOption{}
+	if input.Name != "" && input.Name != label {
+		in.Name = &input.Name
+	}
+	if input.Color != "" {
+		in.Color = &input.Color
+	}
+	if input.Description != "" {
+		in.Description = &input.Description
+	}
+	out, resp, err := s.client.GiteaClient.EditLabel(namespace, name, labelID, in)
+	return convertLabel(out), toSCMResponse(resp), err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, label string) (*scm.Response, error) {
+	labelID, res, err := s.lookupLabel(ctx, repo, label)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	resp, err := s.client.GiteaClient.DeleteLabel(namespace, name, labelID)
+	return toSCMResponse(resp), err
+}
+
+// lookupLabel returns the identifier of the repository label with
+// the given name, or scm.ErrNotFound.
+func (s *repositoryService) lookupLabel(ctx context.Context, repo, label string) (int64, *scm.Response, error) {
+	labelID, res, err := (&issueService{client: s.client}).lookupLabel(ctx, repo, label)
+	if err != nil {
+		return 0, res, err
+	}
+	if labelID == -1 {
+		return 0, res, scm.ErrNotFound
+	}
+	return labelID, res, nil
+}
+
 func (s *repositoryService) Find(_ context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	out, resp, err := s.client.GiteaClient.GetRepo(namespace, name)
@@ -228,6 +285,12 @@ func (s *repositoryService) Delete(_ context.Context, repo string) (*scm.Respons
 	return toSCMResponse(resp), err
 }
 
+func (s *repositoryService) SetDefaultBranch(_ context.Context, repo, branch string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	_, resp, err := s.client.GiteaClient.EditRepo(namespace, name, gitea.EditRepoOption{DefaultBranch: &branch})
+	return toSCMResponse(resp), err
+}
+
 //
 // native data structure conversion
 //
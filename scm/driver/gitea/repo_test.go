@@ -122,6 +122,27 @@ func TestRepoNotFound(t *testing.T) {
 	}
 }
 
+func TestRepoSetDefaultBranch(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.SetDefaultBranch(context.Background(), "go-gitea/gitea", "develop")
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 //
 // hook sub-tests
 //
@@ -354,3 +375,104 @@ func TestStatusCreate(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestRepoCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/labels").
+		BodyString(`"name":"bug"`).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":1,"name":"bug","color":"ee0701","description":"Something is not working","url":"https://try.gitea.io/api/v1/repos/go-gitea/gitea/labels/1"}`)
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.CreateLabel(context.Background(), "go-gitea/gitea", &scm.LabelInput{Name: "bug", Color: "#ee0701", Description: "Something is not working"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Label{
+		ID:          1,
+		URL:         "https://try.gitea.io/api/v1/repos/go-gitea/gitea/labels/1",
+		Name:        "bug",
+		Description: "Something is not working",
+		Color:       "ee0701",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Patch("/api/v1/repos/go-gitea/gitea/labels/2").
+		BodyString(`"name":"enhancement"`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":2,"name":"enhancement","color":"84b6eb","description":"New functionality"}`)
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "go-gitea/gitea", "feature", &scm.LabelInput{Name: "enhancement"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "enhancement" {
+		t.Errorf("Want label name enhancement, got %s", got.Name)
+	}
+}
+
+func TestRepoDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/labels/1").
+		Reply(204)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.DeleteLabel(context.Background(), "go-gitea/gitea", "bug")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepoDeleteLabelNotFound(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.DeleteLabel(context.Background(), "go-gitea/gitea", "wontfix")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want error %v, got %v", scm.ErrNotFound, err)
+	}
+}
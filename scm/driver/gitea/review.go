@@ -6,6 +6,7 @@ package gitea
 
 import (
 	"context"
+	"fmt"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/jenkins-x/go-scm/scm"
@@ -72,11 +73,58 @@ func (s *reviewService) Submit(ctx context.Context, repo string, prID int, revie
 	return convertReview(review), toSCMResponse(resp), err
 }
 
-// TODO: Figure out whether this actually is a _thing_ exactly in Gitea. I don't think it is.
+// Dismiss dismisses a review. Review dismissal is only available
+// from Gitea 1.14 onwards.
 func (s *reviewService) Dismiss(ctx context.Context, repo string, prID int, reviewID int, msg string) (*scm.Review, *scm.Response, error) {
+	if !s.client.serverAtLeast(ctx, "1.14") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	in := gitea.DismissPullReviewOptions{
+		Message: msg,
+	}
+	resp, err := s.client.GiteaClient.DismissPullReview(namespace, name, int64(prID), int64(reviewID), in)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return s.Find(ctx, repo, prID, reviewID)
+}
+
+// CreateComment creates a review comment by submitting a review with
+// a single comment. The SDK is not used since it requires a review
+// body.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.ReviewComment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := gitea.CreatePullReviewOptions{
+		State:    gitea.ReviewStateComment,
+		Comments: toCreatePullRequestComments([]*scm.ReviewCommentInput{input}),
+	}
+	review := new(gitea.PullReview)
+	res, err := s.client.do(ctx, "POST", path, in, review)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	comments, resp, err := s.client.GiteaClient.ListPullReviewComments(namespace, name, int64(number), review.ID)
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	if len(comments) == 0 {
+		return nil, toSCMResponse(resp), scm.ErrNotFound
+	}
+	return convertReviewComment(comments[0]), toSCMResponse(resp), nil
+}
+
+// ReplyToComment is not supported by the Gitea API.
+func (s *reviewService) ReplyToComment(ctx context.Context, repo string, number, id int, body string) (*scm.ReviewComment, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// ResolveThread is not supported by the Gitea API.
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func convertReviewList(from []*gitea.PullReview) []*scm.Review {
 	to := []*scm.Review{}
 	for _, v := range from {
@@ -109,26 +157,40 @@ func convertReviewCommentList(src []*gitea.PullReviewComment) []*scm.ReviewComme
 }
 
 func convertReviewComment(src *gitea.PullReviewComment) *scm.ReviewComment {
-	return &scm.ReviewComment{
+	dst := &scm.ReviewComment{
 		ID:      int(src.ID),
 		Body:    src.Body,
 		Path:    src.Path,
 		Sha:     src.CommitID,
 		Line:    int(src.LineNum),
+		Side:    scm.SideRight,
 		Link:    src.HTMLURL,
 		Author:  *convertUser(src.Reviewer),
 		Created: src.Created,
 		Updated: src.Updated,
 	}
+	if src.LineNum == 0 && src.OldLineNum != 0 {
+		dst.Line = int(src.OldLineNum)
+		dst.Side = scm.SideLeft
+	}
+	return dst
 }
+
+// toCreatePullRequestComments converts the review comments. Gitea
+// comments a single line, the last line of a range.
 func toCreatePullRequestComments(src []*scm.ReviewCommentInput) []gitea.CreatePullReviewComment {
 	var out []gitea.CreatePullReviewComment
 	for _, c := range src {
-		out = append(out, gitea.CreatePullReviewComment{
-			Path:       c.Path,
-			Body:       c.Body,
-			NewLineNum: int64(c.Line),
-		})
+		comment := gitea.CreatePullReviewComment{
+			Path: c.Path,
+			Body: scm.FormatSuggestion(c.Body, c.Suggestion, "suggestion"),
+		}
+		if c.Side == scm.SideLeft {
+			comment.OldLineNum = int64(c.Line)
+		} else {
+			comment.NewLineNum = int64(c.Line)
+		}
+		out = append(out, comment)
 	}
 	return out
 }
@@ -156,3 +156,101 @@ func TestReviewDelete(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestReviewDismiss(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		File("testdata/version_1_14.json")
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/1/dismissals").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Reviews.Dismiss(context.Background(), "jcitizen/my-repo", 1, 1, "stale review")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	err = json.Unmarshal(raw, &want)
+	assert.NoError(t, err)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewDismissOldServer(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	client.SetServerInfo(&scm.ServerInfo{Product: "gitea", Version: "1.12.4"})
+	_, _, err := client.Reviews.Dismiss(context.Background(), "jcitizen/my-repo", 1, 1, "stale review")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error on servers older than 1.14")
+	}
+}
+
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		BodyString(`"old_position":6`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/1/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comments.json")
+
+	client, _ := New("https://try.gitea.io")
+	in := &scm.ReviewCommentInput{
+		Body: "Use a constant.",
+		Path: "main.go",
+		Line: 6,
+		Side: scm.SideLeft,
+	}
+	got, _, err := client.Reviews.CreateComment(context.Background(), "jcitizen/my-repo", 1, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 7, got.ID)
+	assert.Equal(t, 6, got.Line)
+	assert.Equal(t, scm.SideLeft, got.Side)
+	assert.Equal(t, "main.go", got.Path)
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Reviews.ResolveThread(context.Background(), "jcitizen/my-repo", 1, "7")
+	assert.Equal(t, scm.ErrNotSupported, err)
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// searchService searches repositories and pull requests. The
+// Gitea API does not provide code and commit search.
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCode, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCommit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// Repositories searches the repositories of the organization or
+// the instance. The repository of the options is ignored.
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.Repository, *scm.Response, error) {
+	in := gitea.SearchRepoOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     opts.Page,
+			PageSize: opts.Size,
+		},
+		Keyword: opts.Query,
+		Sort:    opts.Sort,
+	}
+	if opts.Sort != "" {
+		in.Order = "desc"
+		if opts.Ascending {
+			in.Order = "asc"
+		}
+	}
+	if opts.Org != "" {
+		owner, resp, err := s.client.GiteaClient.GetUserInfo(opts.Org)
+		if err != nil {
+			return nil, toSCMResponse(resp), err
+		}
+		in.OwnerID = owner.ID
+	}
+	out, resp, err := s.client.GiteaClient.SearchRepos(in)
+	return convertRepositoryList(out), toSCMResponse(resp), err
+}
+
+func (s *searchService) PullRequests(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("type", string(gitea.IssueTypePull))
+	params.Set("state", string(gitea.StateAll))
+	params.Set("q", opts.Query)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	path := fmt.Sprintf("api/v1/repos/issues/search?%s", params.Encode())
+	if opts.Repo != "" {
+		path = fmt.Sprintf("api/v1/repos/%s/issues?%s", opts.Repo, params.Encode())
+	} else if opts.Org != "" {
+		params.Set("owner", opts.Org)
+		path = fmt.Sprintf("api/v1/repos/issues/search?%s", params.Encode())
+	}
+	out := []*gitea.Issue{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertSearchPullRequestList(out, opts.Repo), res, err
+}
+
+func convertSearchPullRequestList(from []*gitea.Issue, repo string) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		to = append(to, convertSearchPullRequest(v, repo))
+	}
+	return to
+}
+
+// convertSearchPullRequest converts the issue returned by the
+// search to a pull request. The branches are not included in the
+// search results. The issues of a repository do not include the
+// repository, which is then set from the full name.
+func convertSearchPullRequest(from *gitea.Issue, repo string) *scm.PullRequest {
+	issue := convertIssue(from)
+	to := &scm.PullRequest{
+		Number:    issue.Number,
+		Title:     issue.Title,
+		Body:      issue.Body,
+		Labels:    issue.Labels,
+		State:     string(from.State),
+		Closed:    issue.Closed,
+		Author:    issue.Author,
+		Assignees: issue.Assignees,
+		Created:   issue.Created,
+		Updated:   issue.Updated,
+		Link:      from.HTMLURL,
+	}
+	if from.PullRequest != nil {
+		to.Merged = from.PullRequest.HasMerged
+	}
+	if from.Repository != nil {
+		repo = from.Repository.FullName
+	}
+	namespace, name := scm.Split(repo)
+	to.Base.Repo = scm.Repository{
+		Namespace: namespace,
+		Name:      name,
+		FullName:  repo,
+	}
+	return to
+}
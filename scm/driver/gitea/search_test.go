@@ -0,0 +1,55 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSearchPullRequests(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/issues").
+		MatchParam("type", "pulls").
+		MatchParam("state", "all").
+		MatchParam("q", "bug").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://try.gitea.io")
+	opts := scm.SearchQueryOptions{Query: "bug", Repo: "go-gitea/gitea"}
+	got, _, err := client.Search.PullRequests(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 {
+		t.Errorf("Want 1 pull request, got %d", len(got))
+		return
+	}
+	if want := "go-gitea/gitea"; got[0].Base.Repo.FullName != want {
+		t.Errorf("Want base repository %s, got %s", want, got[0].Base.Repo.FullName)
+	}
+}
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Search.Code(context.Background(), scm.SearchQueryOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -0,0 +1,23 @@
+package gitea
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	version, resp, err := s.client.GiteaClient.ServerVersion()
+	if err != nil {
+		return nil, toSCMResponse(resp), err
+	}
+	return &scm.ServerInfo{
+		Product: scm.DriverGitea.String(),
+		Version: version,
+		Edition: scm.EditionCommunity,
+	}, toSCMResponse(resp), nil
+}
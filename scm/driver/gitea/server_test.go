@@ -0,0 +1,39 @@
+package gitea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestServerFind(t *testing.T) {
+	defer gock.Off()
+
+	mockServerVersion()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/version").
+		Reply(200).
+		Type("application/json").
+		File("testdata/version.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, err := client.ServerInfo(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.ServerInfo{
+		Product: "gitea",
+		Version: "1.12.4",
+		Edition: scm.EditionCommunity,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
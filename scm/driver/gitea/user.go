@@ -44,6 +44,15 @@ func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *
 	return convertUser(out), toSCMResponse(resp), err
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	// the token scopes and expiry cannot be introspected with the token itself.
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.TokenInfo{User: user}, res, nil
+}
+
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
 	user, res, err := s.Find(ctx)
 	if user != nil {
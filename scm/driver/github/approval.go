@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+// codeOwnerRule is the name of the rule requiring a review from the
+// code owners, who are not exposed by the api.
+const codeOwnerRule = "Code owner review"
+
+// ListRules returns the required reviews of the branch protection
+// of the pull request base branch. Reading the branch protection
+// requires admin access to the repository.
+//
+// See https://docs.github.com/en/rest/branches/branch-protection#get-branch-protection
+func (s *approvalService) ListRules(ctx context.Context, repo string, number int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	pr, res, err := s.client.PullRequests.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("repos/%s/branches/%s", repo, pr.Base.Ref)
+	base := new(branch)
+	res, err = s.client.do(ctx, "GET", path, nil, base)
+	if err != nil {
+		return nil, res, err
+	}
+	if !base.Protected {
+		return []*scm.ApprovalRule{}, res, nil
+	}
+	path = fmt.Sprintf("repos/%s/branches/%s/protection", repo, pr.Base.Ref)
+	out := new(protection)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if err == scm.ErrNotFound {
+		// the protection of a protected branch is not found
+		// without admin access, so the rules are unknown.
+		return nil, res, fmt.Errorf("cannot read the protection of branch %s, which requires admin access", pr.Base.Ref)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	return convertRequiredReviews(out.RequiredPullRequestReviews), res, nil
+}
+
+// FindStatus evaluates the required reviews against the latest
+// review of each reviewer. The code owners are not exposed by the
+// api, so the code owner review is only satisfied when GitHub
+// reports the pull request as approved.
+func (s *approvalService) FindStatus(ctx context.Context, repo string, number int) (*scm.ApprovalStatus, *scm.Response, error) {
+	rules, res, err := s.ListRules(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	reviews, res, err := (&reviewService{s.client}).listAll(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	status := scm.EvaluateApprovals(rules, approvedBy(reviews))
+	for _, rule := range rules {
+		if rule.Name != codeOwnerRule || !rule.Approved {
+			continue
+		}
+		pulls := &pullService{&issueService{s.client}}
+		decision, res, err := pulls.findReviewDecision(ctx, repo, number)
+		if err != nil {
+			return nil, res, err
+		}
+		if decision != scm.ReviewDecisionApproved {
+			rule.Approved = false
+			status.Approved = false
+			status.Missing = append(status.Missing, rule)
+		}
+	}
+	return status, res, nil
+}
+
+func (s *approvalService) Approve(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	_, res, err := s.client.Reviews.Create(ctx, repo, number, &scm.ReviewInput{Event: "APPROVE"})
+	return res, err
+}
+
+// Unapprove dismisses the approving reviews of the authenticated user.
+func (s *approvalService) Unapprove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return res, err
+	}
+	reviews, res, err := (&reviewService{s.client}).listAll(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	for _, review := range reviews {
+		if review.State != scm.ReviewStateApproved || review.Author.Login != user.Login {
+			continue
+		}
+		_, res, err = s.client.Reviews.Dismiss(ctx, repo, number, review.ID, "Approval revoked")
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type protection struct {
+	RequiredPullRequestReviews *requiredReviews `json:"required_pull_request_reviews"`
+}
+
+type requiredReviews struct {
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+}
+
+func convertRequiredReviews(from *requiredReviews) []*scm.ApprovalRule {
+	to := []*scm.ApprovalRule{}
+	if from == nil {
+		return to
+	}
+	if from.RequiredApprovingReviewCount > 0 {
+		to = append(to, &scm.ApprovalRule{
+			Name:     "Required approving reviews",
+			Required: from.RequiredApprovingReviewCount,
+		})
+	}
+	if from.RequireCodeOwnerReviews {
+		to = append(to, &scm.ApprovalRule{
+			Name:     codeOwnerRule,
+			Required: 1,
+		})
+	}
+	return to
+}
+
+// approvedBy returns the users whose latest review approves
+// the pull request, in the order of the reviews.
+func approvedBy(reviews []*scm.Review) []scm.User {
+	latest := map[string]string{}
+	for _, review := range reviews {
+		switch review.State {
+		case scm.ReviewStateApproved, scm.ReviewStateChangesRequested, scm.ReviewStateDismissed:
+			latest[review.Author.Login] = review.State
+		}
+	}
+	users := []scm.User{}
+	for _, review := range reviews {
+		if latest[review.Author.Login] == scm.ReviewStateApproved {
+			users = append(users, review.Author)
+			delete(latest, review.Author.Login)
+		}
+	}
+	return users
+}
@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestApprovalListRules(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	client := NewDefault()
+	got, res, err := client.Approvals.ListRules(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.ApprovalRule{
+		{Name: "Required approving reviews", Required: 2},
+		{Name: "Code owner review", Required: 1},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestApprovalListRules_Unprotected(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"name":"master","protected":false}`)
+
+	client := NewDefault()
+	got, _, err := client.Approvals.ListRules(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want no approval rules, got %d", len(got))
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestApprovalListRules_NoAdminAccess(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(404).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	_, _, err := client.Approvals.ListRules(context.Background(), "octocat/hello-world", 1347)
+	if err == nil {
+		t.Errorf("Expect error when the branch protection cannot be read")
+	}
+}
+
+func TestApprovalFindStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/branches/master/protection").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch_protection.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		BodyString(`[]`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		MatchParam("page", "2").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reviews_list.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`reviewDecision.*"number":1347`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_review_decision.json")
+
+	client := NewDefault()
+	got, _, err := client.Approvals.FindStatus(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ApprovalStatus)
+	raw, _ := ioutil.ReadFile("testdata/approval_status.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestApprovalApprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/reviews").
+		JSON(map[string]interface{}{"event": "APPROVE"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reviews_create.json")
+
+	client := NewDefault()
+	res, err := client.Approvals.Approve(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestApprovalUnapprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/reviews").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reviews_list.json")
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/reviews/80/dismissals").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reviews_dismiss.json")
+
+	client := NewDefault()
+	_, err := client.Approvals.Unapprove(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -6,9 +6,12 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -24,6 +27,39 @@ func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Re
 	return convertBranch(out), res, err
 }
 
+// CreateBranch creates a branch from the given ref. The ref is
+// resolved to a commit first, because the api requires a sha.
+//
+// See https://docs.github.com/en/rest/git/refs#create-a-reference
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/commits/%s", repo, input.Ref)
+	head := new(commit)
+	res, err := s.client.do(ctx, "GET", path, nil, head)
+	if err != nil {
+		return nil, res, err
+	}
+	path = fmt.Sprintf("repos/%s/git/refs", repo)
+	in := map[string]string{
+		"ref": scm.ExpandRef(input.Name, "refs/heads"),
+		"sha": head.Sha,
+	}
+	out := new(gitRef)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertRef(out), res, err
+}
+
+// RenameBranch renames a branch. Open pull requests and branch
+// protection rules are updated to the new name.
+//
+// See https://docs.github.com/en/rest/branches/branches#rename-a-branch
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/branches/%s/rename", repo, branchName)
+	in := map[string]string{"new_name": name}
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertBranch(out), res, err
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/commits/%s", repo, ref)
 	out := new(commit)
@@ -67,6 +103,35 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return scmRef, res, err
 }
 
+// CreateTag creates an annotated tag object and the reference
+// pointing to it.
+//
+// See https://docs.github.com/en/rest/git/tags#create-a-tag-object
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	if input.Message == "" {
+		return nil, nil, scm.ErrTagMessageRequired
+	}
+	path := fmt.Sprintf("repos/%s/git/tags", repo)
+	in := &tagInput{
+		Tag:     input.Name,
+		Message: input.Message,
+		Object:  input.Target,
+		Type:    "commit",
+		Tagger:  convertSignatureInput(input.Tagger),
+	}
+	out := new(tagObject)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the tag object is not reachable until it is referenced.
+	_, res, err = s.CreateRef(ctx, repo, "refs/tags/"+input.Name, out.Sha)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertTagObject(out), res, nil
+}
+
 // DeleteRef deletes the given ref
 //
 // See https://developer.github.com/v3/git/refs/#delete-a-reference
@@ -80,6 +145,27 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return nil, nil, scm.ErrNotSupported
 }
 
+// FindTagObject finds the tag reference, and the tag object it
+// points to when the tag is annotated.
+//
+// See https://docs.github.com/en/rest/git/tags#get-a-tag
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/refs/tags/%s", repo, name)
+	ref := new(gitRef)
+	res, err := s.client.do(ctx, "GET", path, nil, ref)
+	if err != nil {
+		return nil, res, err
+	}
+	if ref.Object.Type != "tag" {
+		// a lightweight tag points at the commit directly.
+		return &scm.Tag{Name: name, Target: ref.Object.Sha, TargetType: ref.Object.Type}, res, nil
+	}
+	path = fmt.Sprintf("repos/%s/git/tags/%s", repo, ref.Object.Sha)
+	out := new(tagObject)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	return convertTagObject(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/branches?%s", repo, encodeListOptions(opts))
 	out := []*branch{}
@@ -88,6 +174,9 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
+	if opts.FirstParent {
+		return nil, nil, scm.ErrNotSupported
+	}
 	path := fmt.Sprintf("repos/%s/commits?%s", repo, encodeCommitListOptions(opts))
 	out := []*commit{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
@@ -115,6 +204,356 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return convertChangeList(out.Files), res, err
 }
 
+// UpdateRef moves the given ref, such as "heads/master", to another commit.
+//
+// See https://docs.github.com/en/rest/git/refs#update-a-reference
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	// the api does not support compare-and-swap, so the current
+	// commit is checked first. This is not atomic; a concurrent
+	// update between the two requests is not detected. The update
+	// still fails if it is not a fast forward, unless it is forced.
+	if input.OldSha != "" {
+		sha, res, err := s.FindRef(ctx, repo, ref)
+		if err != nil {
+			return nil, res, err
+		}
+		if sha != input.OldSha {
+			return nil, res, scm.ErrRefMismatch
+		}
+	}
+	path := fmt.Sprintf("repos/%s/git/refs/%s", repo, ref)
+	in := &refInput{
+		Sha:   input.Sha,
+		Force: input.Force,
+	}
+	out := new(gitRef)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertRef(out), res, err
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/blobs/%s", repo, sha)
+	out := new(blob)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	content, err := base64.StdEncoding.DecodeString(out.Content)
+	return &scm.Blob{Sha: out.Sha, Size: out.Size, Content: content}, res, err
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/blobs", repo)
+	in := &blob{
+		Content:  base64.StdEncoding.EncodeToString(content),
+		Encoding: "base64",
+	}
+	out := new(blob)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return &scm.Blob{Sha: out.Sha, Size: int64(len(content))}, res, err
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/trees/%s", repo, sha)
+	if recursive {
+		path += "?recursive=1"
+	}
+	out := new(tree)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertTree(out), res, err
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	in := &treeInput{BaseTree: input.BaseTree}
+	for _, entry := range input.Entries {
+		in.Tree = append(in.Tree, convertTreeEntryInput(entry))
+	}
+	return s.createTree(ctx, repo, in)
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/commits", repo)
+	in := &commitInput{
+		Message:   input.Message,
+		Tree:      input.Tree,
+		Parents:   input.Parents,
+		Author:    convertSignatureInput(input.Author),
+		Committer: convertSignatureInput(input.Committer),
+	}
+	if in.Parents == nil {
+		in.Parents = []string{}
+	}
+	out := new(gitCommit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertGitCommit(out), res, err
+}
+
+// CommitFiles atomically commits the changes of many files by creating
+// a tree and a commit with the git data api, and then fast forwarding
+// the branch to the new commit.
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	newBranch := false
+	head, res, err := s.FindRef(ctx, repo, "heads/"+input.Branch)
+	if err == scm.ErrNotFound && input.BaseBranch != "" {
+		newBranch = true
+		head, res, err = s.FindRef(ctx, repo, "heads/"+input.BaseBranch)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	parent := new(gitCommit)
+	res, err = s.client.do(ctx, "GET", fmt.Sprintf("repos/%s/git/commits/%s", repo, head), nil, parent)
+	if err != nil {
+		return nil, res, err
+	}
+
+	in := &treeInput{BaseTree: parent.Tree.Sha}
+	for _, file := range input.Files {
+		entries, res, err := s.fileChangeEntries(ctx, repo, head, file)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Tree = append(in.Tree, entries...)
+	}
+	tree, res, err := s.createTree(ctx, repo, in)
+	if err != nil {
+		return nil, res, err
+	}
+
+	commit, res, err := s.CreateCommit(ctx, repo, &scm.CommitInput{
+		Message: input.Message,
+		Tree:    tree.Sha,
+		Parents: []string{head},
+		Author:  input.Author,
+	})
+	if err != nil {
+		return nil, res, err
+	}
+
+	if newBranch {
+		_, res, err = s.CreateRef(ctx, repo, "refs/heads/"+input.Branch, commit.Sha)
+	} else {
+		// the update is rejected if the branch was pushed to in
+		// the meantime, since it is no longer a fast forward.
+		_, res, err = s.UpdateRef(ctx, repo, "heads/"+input.Branch, &scm.RefInput{Sha: commit.Sha})
+	}
+	return commit, res, err
+}
+
+// MergeBranch merges the head into the base branch. The api
+// responds with no content when there is nothing to merge.
+//
+// See https://docs.github.com/en/rest/branches/branches#merge-a-branch
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/merges", repo)
+	in := &mergeInput{
+		Base:          input.Base,
+		Head:          input.Head,
+		CommitMessage: input.Message,
+	}
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	switch {
+	case res != nil && res.Status == http.StatusNoContent:
+		return nil, res, nil
+	case res != nil && res.Status == http.StatusConflict:
+		return nil, res, scm.MergeConflict{Message: fmt.Sprintf("cannot merge %s into %s", input.Head, input.Base)}
+	case err != nil:
+		return nil, res, err
+	}
+	return convertCommit(out), res, nil
+}
+
+func (s *gitService) createTree(ctx context.Context, repo string, in *treeInput) (*scm.Tree, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/git/trees", repo)
+	out := new(tree)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertTree(out), res, err
+}
+
+// fileChangeEntries returns the tree entries which apply the file change
+// to the tree of the given commit.
+func (s *gitService) fileChangeEntries(ctx context.Context, repo, ref string, file *scm.FileChange) ([]*treeEntryInput, *scm.Response, error) {
+	mode := scm.TreeModeFile
+	if file.Executable {
+		mode = scm.TreeModeExecutable
+	}
+	switch file.Action {
+	case scm.FileActionDelete:
+		return []*treeEntryInput{{Path: file.Path, Mode: mode, Type: "blob", Delete: true}}, nil, nil
+	case scm.FileActionMove:
+		entry := &treeEntryInput{Path: file.Path, Mode: mode, Type: "blob"}
+		if file.Content != nil {
+			entry.Content = string(file.Content)
+		} else {
+			// keep the content of the file by reusing its blob.
+			path := fmt.Sprintf("repos/%s/contents/%s?ref=%s", repo, file.PreviousPath, ref)
+			out := new(content)
+			res, err := s.client.do(ctx, "GET", path, nil, out)
+			if err != nil {
+				return nil, res, err
+			}
+			entry.Sha = &out.Sha
+		}
+		deleted := &treeEntryInput{Path: file.PreviousPath, Mode: mode, Type: "blob", Delete: true}
+		return []*treeEntryInput{deleted, entry}, nil, nil
+	}
+	entry := &treeEntryInput{Path: file.Path, Mode: mode, Type: "blob"}
+	if utf8.Valid(file.Content) {
+		entry.Content = string(file.Content)
+		return []*treeEntryInput{entry}, nil, nil
+	}
+	// binary content must be uploaded as a blob.
+	blob, res, err := s.CreateBlob(ctx, repo, file.Content)
+	if err != nil {
+		return nil, res, err
+	}
+	entry.Sha = &blob.Sha
+	return []*treeEntryInput{entry}, res, nil
+}
+
+type gitRef struct {
+	Ref    string `json:"ref"`
+	Object struct {
+		Type string `json:"type"`
+		Sha  string `json:"sha"`
+	} `json:"object"`
+}
+
+type refInput struct {
+	Sha   string `json:"sha"`
+	Force bool   `json:"force"`
+}
+
+type mergeInput struct {
+	Base          string `json:"base"`
+	Head          string `json:"head"`
+	CommitMessage string `json:"commit_message,omitempty"`
+}
+
+type blob struct {
+	Sha      string `json:"sha,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type tree struct {
+	Sha       string       `json:"sha"`
+	Tree      []*treeEntry `json:"tree"`
+	Truncated bool         `json:"truncated"`
+}
+
+type treeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+	Type string `json:"type"`
+	Sha  string `json:"sha"`
+	Size int64  `json:"size"`
+}
+
+type treeInput struct {
+	BaseTree string            `json:"base_tree,omitempty"`
+	Tree     []*treeEntryInput `json:"tree"`
+}
+
+// treeEntryInput is a tree entry with either a blob sha or the
+// content. A deleted entry removes the path from the tree.
+type treeEntryInput struct {
+	Path    string
+	Mode    string
+	Type    string
+	Sha     *string
+	Content string
+	Delete  bool
+}
+
+// MarshalJSON encodes a deleted entry with a null sha, which removes
+// the path from the tree. Otherwise the entry has either a sha or the
+// content, which may be empty.
+func (e *treeEntryInput) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Path    string  `json:"path"`
+		Mode    string  `json:"mode"`
+		Type    string  `json:"type"`
+		Sha     *string `json:"sha,omitempty"`
+		Content *string `json:"content,omitempty"`
+	}{Path: e.Path, Mode: e.Mode, Type: e.Type}
+	switch {
+	case e.Delete:
+		return json.Marshal(&struct {
+			Path string  `json:"path"`
+			Mode string  `json:"mode"`
+			Type string  `json:"type"`
+			Sha  *string `json:"sha"`
+		}{Path: e.Path, Mode: e.Mode, Type: e.Type})
+	case e.Sha != nil:
+		out.Sha = e.Sha
+	default:
+		out.Content = &e.Content
+	}
+	return json.Marshal(&out)
+}
+
+type commitInput struct {
+	Message   string          `json:"message"`
+	Tree      string          `json:"tree"`
+	Parents   []string        `json:"parents"`
+	Author    *signatureInput `json:"author,omitempty"`
+	Committer *signatureInput `json:"committer,omitempty"`
+}
+
+type signatureInput struct {
+	Name  string     `json:"name"`
+	Email string     `json:"email"`
+	Date  *time.Time `json:"date,omitempty"`
+}
+
+type tagInput struct {
+	Tag     string          `json:"tag"`
+	Message string          `json:"message"`
+	Object  string          `json:"object"`
+	Type    string          `json:"type"`
+	Tagger  *signatureInput `json:"tagger,omitempty"`
+}
+
+type tagObject struct {
+	Tag     string `json:"tag"`
+	Sha     string `json:"sha"`
+	Message string `json:"message"`
+	Tagger  struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"tagger"`
+	Object struct {
+		Type string `json:"type"`
+		Sha  string `json:"sha"`
+	} `json:"object"`
+	Verification *verification `json:"verification"`
+}
+
+type gitCommit struct {
+	Sha     string `json:"sha"`
+	URL     string `json:"html_url"`
+	Message string `json:"message"`
+	Tree    struct {
+		Sha string `json:"sha"`
+		URL string `json:"url"`
+	} `json:"tree"`
+	Author struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"author"`
+	Committer struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"committer"`
+}
+
 type branch struct {
 	Name      string `json:"name"`
 	Commit    commit `json:"commit"`
@@ -139,7 +578,8 @@ type commit struct {
 			Email string    `json:"email"`
 			Date  time.Time `json:"date"`
 		} `json:"committer"`
-		Message string `json:"message"`
+		Message      string        `json:"message"`
+		Verification *verification `json:"verification"`
 	} `json:"commit"`
 	Author struct {
 		AvatarURL string `json:"avatar_url"`
@@ -149,9 +589,23 @@ type commit struct {
 		AvatarURL string `json:"avatar_url"`
 		Login     string `json:"login"`
 	} `json:"committer"`
+	Parents []struct {
+		Sha string `json:"sha"`
+	} `json:"parents"`
+	Stats *struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+		Total     int `json:"total"`
+	} `json:"stats"`
 	Files []*file `json:"files"`
 }
 
+type verification struct {
+	Verified  bool   `json:"verified"`
+	Reason    string `json:"reason"`
+	Signature string `json:"signature"`
+}
+
 func convertCommitList(from []*commit) []*scm.Commit {
 	to := []*scm.Commit{}
 	for _, v := range from {
@@ -161,7 +615,7 @@ func convertCommitList(from []*commit) []*scm.Commit {
 }
 
 func convertCommit(from *commit) *scm.Commit {
-	return &scm.Commit{
+	to := &scm.Commit{
 		Message: from.Commit.Message,
 		Sha:     from.Sha,
 		Tree: scm.CommitTree{
@@ -184,6 +638,134 @@ func convertCommit(from *commit) *scm.Commit {
 			Avatar: from.Committer.AvatarURL,
 		},
 	}
+	for _, parent := range from.Parents {
+		to.Parents = append(to.Parents, parent.Sha)
+	}
+	if from.Stats != nil {
+		to.Stats = &scm.CommitStats{
+			Additions: from.Stats.Additions,
+			Deletions: from.Stats.Deletions,
+			Total:     from.Stats.Total,
+		}
+	}
+	if len(from.Files) != 0 {
+		to.Files = convertChangeList(from.Files)
+	}
+	if v := from.Commit.Verification; v != nil {
+		to.Verification = &scm.Verification{
+			Verified:  v.Verified,
+			Reason:    v.Reason,
+			Signature: v.Signature,
+		}
+		// the signature is only verified when the key belongs
+		// to the committer email.
+		if v.Verified {
+			to.Verification.Signer = from.Commit.Committer.Email
+		}
+	}
+	return to
+}
+
+func convertGitCommit(from *gitCommit) *scm.Commit {
+	return &scm.Commit{
+		Sha:     from.Sha,
+		Message: from.Message,
+		Tree: scm.CommitTree{
+			Sha:  from.Tree.Sha,
+			Link: from.Tree.URL,
+		},
+		Link: from.URL,
+		Author: scm.Signature{
+			Name:  from.Author.Name,
+			Email: from.Author.Email,
+			Date:  from.Author.Date,
+		},
+		Committer: scm.Signature{
+			Name:  from.Committer.Name,
+			Email: from.Committer.Email,
+			Date:  from.Committer.Date,
+		},
+	}
+}
+
+func convertTagObject(from *tagObject) *scm.Tag {
+	to := &scm.Tag{
+		Name:    from.Tag,
+		Sha:     from.Sha,
+		Message: from.Message,
+		Tagger: scm.Signature{
+			Name:  from.Tagger.Name,
+			Email: from.Tagger.Email,
+			Date:  from.Tagger.Date,
+		},
+		Target:     from.Object.Sha,
+		TargetType: from.Object.Type,
+	}
+	if v := from.Verification; v != nil {
+		to.Verification = &scm.Verification{
+			Verified:  v.Verified,
+			Reason:    v.Reason,
+			Signature: v.Signature,
+		}
+	}
+	return to
+}
+
+func convertSignatureInput(from *scm.Signature) *signatureInput {
+	if from == nil {
+		return nil
+	}
+	to := &signatureInput{Name: from.Name, Email: from.Email}
+	if !from.Date.IsZero() {
+		to.Date = &from.Date
+	}
+	return to
+}
+
+func convertRef(from *gitRef) *scm.Reference {
+	return &scm.Reference{
+		Name: scm.TrimRef(from.Ref),
+		Path: from.Ref,
+		Sha:  from.Object.Sha,
+	}
+}
+
+func convertTree(from *tree) *scm.Tree {
+	to := &scm.Tree{
+		Sha:       from.Sha,
+		Truncated: from.Truncated,
+	}
+	for _, v := range from.Tree {
+		to.Entries = append(to.Entries, &scm.TreeEntry{
+			Path: v.Path,
+			Mode: v.Mode,
+			Type: v.Type,
+			Sha:  v.Sha,
+			Size: v.Size,
+		})
+	}
+	return to
+}
+
+func convertTreeEntryInput(from *scm.TreeEntry) *treeEntryInput {
+	to := &treeEntryInput{
+		Path: from.Path,
+		Mode: from.Mode,
+		Type: from.Type,
+	}
+	if to.Mode == "" {
+		to.Mode = scm.TreeModeFile
+	}
+	if to.Type == "" {
+		to.Type = "blob"
+	}
+	if from.Sha != "" {
+		sha := from.Sha
+		to.Sha = &sha
+	} else {
+		to.Delete = true
+	}
+	return to
 }
 
 func convertBranchList(from []*branch) []*scm.Reference {
@@ -196,9 +778,11 @@ func convertBranchList(from []*branch) []*scm.Reference {
 
 func convertBranch(from *branch) *scm.Reference {
 	return &scm.Reference{
-		Name: scm.TrimRef(from.Name),
-		Path: scm.ExpandRef(from.Name, "refs/heads/"),
-		Sha:  from.Commit.Sha,
+		Name:      scm.TrimRef(from.Name),
+		Path:      scm.ExpandRef(from.Name, "refs/heads/"),
+		Sha:       from.Commit.Sha,
+		Protected: from.Protected,
+		Date:      from.Commit.Commit.Committer.Date,
 	}
 }
 
@@ -77,6 +77,79 @@ func TestGitFindBranch(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/commits/master").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		JSON(map[string]string{"sha": "aa218f56b14c9653891f9e74264a383fa43fefbd"})
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]string{
+			"ref": "refs/heads/featureA",
+			"sha": "aa218f56b14c9653891f9e74264a383fa43fefbd",
+		}).
+		Reply(http.StatusCreated).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref.json")
+
+	client := NewDefault()
+	input := &scm.BranchInput{Name: "featureA", Ref: "master"}
+	got, res, err := client.Git.CreateBranch(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch_create.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitRenameBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/branches/main/rename").
+		JSON(map[string]string{"new_name": "master"}).
+		Reply(http.StatusCreated).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/branch.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.RenameBranch(context.Background(), "octocat/hello-world", "main", "master")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitFindTag(t *testing.T) {
 	git := new(gitService)
 	_, _, err := git.FindTag(context.Background(), "octocat/hello-world", "v1.0")
@@ -120,6 +193,14 @@ func TestGitListCommits(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestGitListCommitsFirstParent(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Git.ListCommits(context.Background(), "octocat/hello-world", scm.CommitListOptions{Ref: "master", FirstParent: true})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitListBranches(t *testing.T) {
 	defer gock.Off()
 
@@ -277,3 +358,481 @@ func TestGitCreateRef(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/tags").
+		JSON(map[string]interface{}{
+			"tag":     "v0.0.1",
+			"message": "initial version",
+			"object":  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"type":    "commit",
+			"tagger":  map[string]string{"name": "Monalisa Octocat", "email": "octocat@github.com"},
+		}).
+		Reply(http.StatusCreated).
+		Type("application/json").
+		File("testdata/tag_object.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/refs").
+		JSON(map[string]string{"ref": "refs/tags/v0.0.1", "sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac"}).
+		Reply(http.StatusCreated).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/ref.json")
+
+	client := NewDefault()
+	input := &scm.TagInput{
+		Name:    "v0.0.1",
+		Message: "initial version",
+		Target:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Tagger:  &scm.Signature{Name: "Monalisa Octocat", Email: "octocat@github.com"},
+	}
+	got, res, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_object.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTagWithoutMessage(t *testing.T) {
+	client := NewDefault()
+	input := &scm.TagInput{
+		Name:   "v0.0.1",
+		Target: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+	_, _, err := client.Git.CreateTag(context.Background(), "octocat/hello-world", input)
+	if err != scm.ErrTagMessageRequired {
+		t.Errorf("Want ErrTagMessageRequired, got %v", err)
+	}
+}
+
+func TestGitFindTagObject(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/tags/v0.0.1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"ref":"refs/tags/v0.0.1","object":{"type":"tag","sha":"940bd336248efae0f9ee5bc7b2d5c985887b16ac"}}`)
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_object.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTagObject(context.Background(), "octocat/hello-world", "v0.0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_object.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitFindTagObjectLightweight(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/tags/v0.0.1").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"ref":"refs/tags/v0.0.1","object":{"type":"commit","sha":"c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"}}`)
+
+	client := NewDefault()
+	got, _, err := client.Git.FindTagObject(context.Background(), "octocat/hello-world", "v0.0.1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Tag{Name: "v0.0.1", Target: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c", TargetType: "commit"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindBlob(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/blob.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindBlob(context.Background(), "octocat/hello-world", "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Blob{
+		Sha:     "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		Size:    12,
+		Content: []byte("Hello World\n"),
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/trees/master").
+		MatchParam("recursive", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTree(context.Background(), "octocat/hello-world", "master", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		JSON(map[string]interface{}{
+			"base_tree": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+			"tree": []map[string]interface{}{
+				{"path": "README", "mode": "100644", "type": "blob", "sha": "44b4fc6d56897b048c772eb4087f854f46256132"},
+				{"path": "lib/hello.sh", "mode": "100644", "type": "blob", "sha": nil},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tree.json")
+
+	client := NewDefault()
+	input := &scm.TreeInput{
+		BaseTree: "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		Entries: []*scm.TreeEntry{
+			{Path: "README", Sha: "44b4fc6d56897b048c772eb4087f854f46256132"},
+			{Path: "lib/hello.sh"},
+		},
+	}
+	got, _, err := client.Git.CreateTree(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Sha, "827efc6d56897b048c772eb4087f854f46256132"; got != want {
+		t.Errorf("Want tree sha %s, got %s", want, got)
+	}
+}
+
+func TestGitCreateCommit(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		JSON(map[string]interface{}{
+			"message": "my commit message",
+			"tree":    "827efc6d56897b048c772eb4087f854f46256132",
+			"parents": []string{"7d1b31e74ee336d15cbd21741bc88a537ed063a0"},
+			"author":  map[string]string{"name": "Monalisa Octocat", "email": "octocat@github.com"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/git_commit.json")
+
+	client := NewDefault()
+	input := &scm.CommitInput{
+		Message: "my commit message",
+		Tree:    "827efc6d56897b048c772eb4087f854f46256132",
+		Parents: []string{"7d1b31e74ee336d15cbd21741bc88a537ed063a0"},
+		Author:  &scm.Signature{Name: "Monalisa Octocat", Email: "octocat@github.com"},
+	}
+	got, res, err := client.Git.CreateCommit(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/git_commit.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitUpdateRef(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/featureA").
+		JSON(map[string]interface{}{"sha": "7638417db6d59f3c431d3e1f261cc637155684cd", "force": true}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"ref":"refs/heads/featureA","object":{"type":"commit","sha":"7638417db6d59f3c431d3e1f261cc637155684cd"}}`)
+
+	client := NewDefault()
+	input := &scm.RefInput{
+		Sha:    "7638417db6d59f3c431d3e1f261cc637155684cd",
+		Force:  true,
+		OldSha: "aa218f56b14c9653891f9e74264a383fa43fefbd",
+	}
+	got, _, err := client.Git.UpdateRef(context.Background(), "octocat/hello-world", "heads/featureA", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Reference{
+		Name: "featureA",
+		Path: "refs/heads/featureA",
+		Sha:  "7638417db6d59f3c431d3e1f261cc637155684cd",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitUpdateRef_Mismatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref.json")
+
+	client := NewDefault()
+	input := &scm.RefInput{
+		Sha:    "7638417db6d59f3c431d3e1f261cc637155684cd",
+		OldSha: "7d1b31e74ee336d15cbd21741bc88a537ed063a0",
+	}
+	_, _, err := client.Git.UpdateRef(context.Background(), "octocat/hello-world", "heads/featureA", input)
+	if err != scm.ErrRefMismatch {
+		t.Errorf("Want ErrRefMismatch, got %v", err)
+	}
+}
+
+func TestGitCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/refs/heads/featureA").
+		Reply(200).
+		Type("application/json").
+		File("testdata/ref.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/git/commits/aa218f56b14c9653891f9e74264a383fa43fefbd").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"sha":"aa218f56b14c9653891f9e74264a383fa43fefbd","tree":{"sha":"9fb037999f264ba9a7fc6274d15fa3ae2ab98312"}}`)
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/blobs").
+		JSON(map[string]string{"content": "iVBORw==", "encoding": "base64"}).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"sha":"3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"}`)
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/trees").
+		JSON(map[string]interface{}{
+			"base_tree": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+			"tree": []map[string]interface{}{
+				{"path": "README", "mode": "100644", "type": "blob", "content": "Hello World\n"},
+				{"path": "logo.png", "mode": "100644", "type": "blob", "sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"},
+				{"path": "lib/hello.sh", "mode": "100644", "type": "blob", "sha": nil},
+				{"path": "logs/.gitkeep", "mode": "100644", "type": "blob", "content": ""},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/tree.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/git/commits").
+		JSON(map[string]interface{}{
+			"message": "my commit message",
+			"tree":    "827efc6d56897b048c772eb4087f854f46256132",
+			"parents": []string{"aa218f56b14c9653891f9e74264a383fa43fefbd"},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/git_commit.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/git/refs/heads/featureA").
+		JSON(map[string]interface{}{"sha": "7638417db6d59f3c431d3e1f261cc637155684cd", "force": false}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"ref":"refs/heads/featureA","object":{"type":"commit","sha":"7638417db6d59f3c431d3e1f261cc637155684cd"}}`)
+
+	client := NewDefault()
+	input := &scm.CommitFilesInput{
+		Branch:  "featureA",
+		Message: "my commit message",
+		Files: []*scm.FileChange{
+			{Action: scm.FileActionUpdate, Path: "README", Content: []byte("Hello World\n")},
+			{Action: scm.FileActionCreate, Path: "logo.png", Content: []byte{0x89, 0x50, 0x4e, 0x47}},
+			{Action: scm.FileActionDelete, Path: "lib/hello.sh"},
+			{Action: scm.FileActionCreate, Path: "logs/.gitkeep"},
+		},
+	}
+	got, _, err := client.Git.CommitFiles(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Sha, "7638417db6d59f3c431d3e1f261cc637155684cd"; got != want {
+		t.Errorf("Want commit sha %s, got %s", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestGitMergeBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/merges").
+		JSON(map[string]string{
+			"base":           "master",
+			"head":           "cool_feature",
+			"commit_message": "Shipped cool_feature!",
+		}).
+		Reply(http.StatusCreated).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	client := NewDefault()
+	input := &scm.MergeInput{
+		Base:    "master",
+		Head:    "cool_feature",
+		Message: "Shipped cool_feature!",
+	}
+	got, res, err := client.Git.MergeBranch(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitMergeBranch_NothingToMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/merges").
+		Reply(http.StatusNoContent).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	input := &scm.MergeInput{Base: "master", Head: "cool_feature"}
+	got, _, err := client.Git.MergeBranch(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got != nil {
+		t.Errorf("Want no merge commit, got %s", got.Sha)
+	}
+}
+
+func TestGitMergeBranch_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/merges").
+		Reply(http.StatusConflict).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Merge Conflict"}`)
+
+	client := NewDefault()
+	input := &scm.MergeInput{Base: "master", Head: "cool_feature"}
+	_, _, err := client.Git.MergeBranch(context.Background(), "octocat/hello-world", input)
+	if !scm.IsMergeConflict(err) {
+		t.Errorf("Want merge conflict error, got %v", err)
+	}
+}
@@ -48,12 +48,17 @@ func New(uri string) (*scm.Client, error) {
 	client.Deployments = &deploymentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.MergeQueues = &mergeQueueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Releases = &releaseService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{&issueService{client}}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Approvals = &approvalService{client}
+	client.Reactions = &reactionService{client}
+	client.Search = &searchService{client}
+	client.Server = &serverService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	client.Apps = &appService{client}
@@ -118,7 +123,22 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return c.doRequest(ctx, req, in, out)
 }
 
+// doUnprocessable is like do, but returns the message of an
+// unprocessable entity response as the error, which tells apart
+// the reasons the request was rejected.
+func (c *wrapper) doUnprocessable(ctx context.Context, method, path string, in, out interface{}) (*scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+	return c.send(ctx, req, in, out, true)
+}
+
 func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out interface{}) (*scm.Response, error) {
+	return c.send(ctx, req, in, out, false)
+}
+
+func (c *wrapper) send(ctx context.Context, req *scm.Request, in, out interface{}, unprocessable bool) (*scm.Response, error) {
 	// if we are posting or putting data, we need to
 	// write it to the body of the request.
 	if in != nil {
@@ -161,6 +181,15 @@ func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out inter
 		if res.Status == 404 {
 			return res, scm.ErrNotFound
 		}
+		if missing := res.MissingScopes(); res.Status == 403 && missing != nil {
+			return res, scm.MissingScopes{Scopes: missing}
+		}
+		if unprocessable && res.Status == http.StatusUnprocessableEntity {
+			out := new(Error)
+			if json.NewDecoder(res.Body).Decode(out) == nil && out.Message != "" {
+				return res, out
+			}
+		}
 		return res, errors.New(
 			http.StatusText(res.Status),
 		)
@@ -175,6 +204,30 @@ func (c *wrapper) doRequest(ctx context.Context, req *scm.Request, in, out inter
 	return res, json.NewDecoder(res.Body).Decode(out)
 }
 
+type graphqlInput struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphqlOutput struct {
+	Data   interface{} `json:"data"`
+	Errors []*Error    `json:"errors"`
+}
+
+// graphql sends the query, or mutation, to the GraphQL endpoint and
+// unmarshals the data of the response.
+func (c *wrapper) graphql(ctx context.Context, in *graphqlInput, out interface{}) (*scm.Response, error) {
+	data := &graphqlOutput{Data: out}
+	res, err := c.do(ctx, "POST", c.GraphQLURL.String(), in, data)
+	if err != nil {
+		return res, err
+	}
+	if len(data.Errors) != 0 {
+		return res, data.Errors[0]
+	}
+	return res, nil
+}
+
 // Error represents a Github error.
 type Error struct {
 	Message string `json:"message"`
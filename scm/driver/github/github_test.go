@@ -5,9 +5,11 @@
 package github
 
 import (
+	"context"
 	"testing"
 
 	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
 )
 
 var mockHeaders = map[string]string{
@@ -78,6 +80,22 @@ func TestClient_Error(t *testing.T) {
 	}
 }
 
+func TestClient_Unprocessable(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/1/comments").
+		Reply(422).
+		Type("application/json").
+		BodyString(`{"message":"Validation Failed"}`)
+
+	client := NewDefault()
+	_, _, err := client.Issues.CreateComment(context.Background(), "octocat/hello-world", 1, &scm.CommentInput{Body: "lgtm"})
+	if err == nil || err.Error() != "Unprocessable Entity" {
+		t.Errorf("Want error Unprocessable Entity, got %v", err)
+	}
+}
+
 func testRate(res *scm.Response) func(t *testing.T) {
 	return func(t *testing.T) {
 		if got, want := res.Rate.Limit, 60; got != want {
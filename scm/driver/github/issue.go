@@ -108,7 +108,41 @@ func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.
 	path := fmt.Sprintf("repos/%s/issues/%d", repo, number)
 	out := new(issue)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertIssue(out), res, err
+	if err != nil || out.PullRequest != nil {
+		return convertIssue(out), res, err
+	}
+	to := convertIssue(out)
+	// the linked pull requests are looked up with the GraphQL API,
+	// which may be unavailable to the token, so a failure leaves
+	// them unset rather than failing to find the issue.
+	if numbers, _, err := s.listLinkedPullRequests(ctx, repo, number); err == nil {
+		to.LinkedPullRequests = numbers
+	}
+	return to, res, nil
+}
+
+// listLinkedPullRequests returns the numbers of the pull requests
+// closing the issue, which are only exposed by the GraphQL API.
+func (s *issueService) listLinkedPullRequests(ctx context.Context, repo string, number int) ([]int, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	in := &graphqlInput{
+		Query: linkedPullRequestsQuery,
+		Variables: map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"number": number,
+		},
+	}
+	out := new(linkedPullRequestsData)
+	res, err := s.client.graphql(ctx, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	numbers := []int{}
+	for _, v := range out.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+		numbers = append(numbers, v.Number)
+	}
+	return numbers, res, nil
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
@@ -135,8 +169,11 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Labels:    input.Labels,
+		Assignees: input.Assignees,
+		Milestone: input.Milestone,
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -257,22 +294,52 @@ type issue struct {
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
 	} `json:"closed_by"`
-	Labels []struct {
+	Labels    []*label   `json:"labels"`
+	Assignees []user     `json:"assignees"`
+	Milestone *milestone `json:"milestone"`
+	Type      *struct {
 		Name string `json:"name"`
-	} `json:"labels"`
-	Assignees []user    `json:"assignees"`
-	Locked    bool      `json:"locked"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	} `json:"type"`
+	Locked    bool       `json:"locked"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
 
 	// This will be non-nil if it is a pull request.
 	PullRequest *struct{} `json:"pull_request,omitempty"`
 }
 
 type issueInput struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
-}
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone int      `json:"milestone,omitempty"`
+}
+
+type linkedPullRequestsData struct {
+	Repository struct {
+		Issue struct {
+			ClosedByPullRequestsReferences struct {
+				Nodes []struct {
+					Number int `json:"number"`
+				} `json:"nodes"`
+			} `json:"closedByPullRequestsReferences"`
+		} `json:"issue"`
+	} `json:"repository"`
+}
+
+const linkedPullRequestsQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    issue(number: $number) {
+      closedByPullRequestsReferences(first: 100, includeClosedPrs: true) {
+        nodes {
+          number
+        }
+      }
+    }
+  }
+}`
 
 type issueComment struct {
 	ID      int    `json:"id"`
@@ -349,12 +416,12 @@ func convertIssue(from *issue) *scm.Issue {
 			Avatar: from.ClosedBy.AvatarURL,
 		}
 	}
-	return &scm.Issue{
+	to := &scm.Issue{
 		Number: from.Number,
 		Title:  from.Title,
 		Body:   from.Body,
 		Link:   from.HTMLURL,
-		Labels: convertLabels(from),
+		Labels: convertLabelObjects(from.Labels),
 		Locked: from.Locked,
 		State:  from.State,
 		Closed: from.State == "closed",
@@ -367,7 +434,15 @@ func convertIssue(from *issue) *scm.Issue {
 		PullRequest: from.PullRequest != nil,
 		Created:     from.CreatedAt,
 		Updated:     from.UpdatedAt,
+		ClosedAt:    from.ClosedAt,
 	}
+	if from.Milestone != nil {
+		to.Milestone = convertMilestone(from.Milestone)
+	}
+	if from.Type != nil {
+		to.Type = from.Type.Name
+	}
+	return to
 }
 
 // helper function to convert from the gogs issue comment list
@@ -396,27 +471,23 @@ func convertIssueComment(from *issueComment) *scm.Comment {
 	}
 }
 
-func convertLabels(from *issue) []string {
-	var labels []string
-	for _, label := range from.Labels {
-		labels = append(labels, label.Name)
-	}
-	return labels
-}
-
 func convertLabelObjects(from []*label) []*scm.Label {
 	var labels []*scm.Label
 	for _, label := range from {
-		labels = append(labels, &scm.Label{
-			Name:        label.Name,
-			Description: label.Description,
-			URL:         label.URL,
-			Color:       label.Color,
-		})
+		labels = append(labels, convertLabelObject(label))
 	}
 	return labels
 }
 
+func convertLabelObject(from *label) *scm.Label {
+	return &scm.Label{
+		Name:        from.Name,
+		Description: from.Description,
+		URL:         from.URL,
+		Color:       from.Color,
+	}
+}
+
 func convertListedIssueEvents(src []*listedIssueEvent) []*scm.ListedIssueEvent {
 	var answer []*scm.ListedIssueEvent
 	for _, from := range src {
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 
@@ -46,6 +47,79 @@ func TestIssueFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueFindLinkedPullRequests(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"number":1347,"state":"closed","title":"Found a bug","closed_at":"2011-04-23T13:33:48Z","type":{"name":"Bug"}}`)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`closedByPullRequestsReferences.*"number":1347`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_linked_pulls.json")
+
+	client := NewDefault()
+	got, res, err := client.Issues.Find(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if diff := cmp.Diff(got.LinkedPullRequests, []int{1348, 1350}); diff != "" {
+		t.Errorf("Unexpected linked pull requests")
+		t.Log(diff)
+	}
+	if got, want := got.Type, "Bug"; got != want {
+		t.Errorf("Want issue type %q, got %q", want, got)
+	}
+	if got.ClosedAt == nil || got.ClosedAt.Format(time.RFC3339) != "2011-04-23T13:33:48Z" {
+		t.Errorf("Unexpected closed at %v", got.ClosedAt)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestIssueFindLinkedPullRequestsUnavailable(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"number":1347,"state":"open","title":"Found a bug"}`)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(403).
+		Type("application/json").
+		BodyString(`{"message":"Resource not accessible by integration"}`)
+
+	client := NewDefault()
+	got, res, err := client.Issues.Find(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Number != 1347 {
+		t.Errorf("Want issue number 1347, got %d", got.Number)
+	}
+	if got.LinkedPullRequests != nil {
+		t.Errorf("Want no linked pull requests, got %v", got.LinkedPullRequests)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCommentFind(t *testing.T) {
 	defer gock.Off()
 
@@ -264,6 +338,36 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueCreateWithLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues").
+		BodyString(`{"title":"Found a bug","body":"I'm having a problem with this.","labels":\["bug"\],"assignees":\["octocat"\],"milestone":1}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Body:      "I'm having a problem with this.",
+		Labels:    []string{"bug"},
+		Assignees: []string{"octocat"},
+		Milestone: 1,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Issues.Create(context.Background(), "octocat/hello-world", &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Milestone == nil || got.Milestone.Number != 1 {
+		t.Errorf("Unexpected milestone %v", got.Milestone)
+	}
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,217 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// mergeQueueService provides access to the merge queues, which are
+// only exposed by the GraphQL api.
+type mergeQueueService struct {
+	client *wrapper
+}
+
+// List returns the entries of the merge queue. The api pages through
+// the queue with cursors, so the pages before the requested page are
+// skipped.
+func (s *mergeQueueService) List(ctx context.Context, repo, branch string, opts scm.ListOptions) ([]*scm.MergeQueueEntry, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	size := opts.Size
+	if size == 0 {
+		size = 30
+	}
+	in := &graphqlInput{
+		Query: mergeQueueQuery,
+		Variables: map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"branch": branch,
+			"first":  size,
+		},
+	}
+	for page := 1; ; page++ {
+		out := new(mergeQueueData)
+		res, err := s.client.graphql(ctx, in, out)
+		if err != nil {
+			return nil, res, err
+		}
+		queue := out.Repository.MergeQueue
+		if queue == nil {
+			return []*scm.MergeQueueEntry{}, res, nil
+		}
+		pageInfo := queue.Entries.PageInfo
+		if page >= opts.Page {
+			if pageInfo.HasNextPage {
+				res.Page.Next = page + 1
+			}
+			return convertMergeQueueEntryList(queue.Entries.Nodes), res, nil
+		}
+		if !pageInfo.HasNextPage {
+			return []*scm.MergeQueueEntry{}, res, nil
+		}
+		in.Variables["cursor"] = pageInfo.EndCursor
+	}
+}
+
+func (s *mergeQueueService) Add(ctx context.Context, repo string, number int, opts *scm.MergeQueueOptions) (*scm.MergeQueueEntry, *scm.Response, error) {
+	pulls := &pullService{&issueService{s.client}}
+	id, res, err := pulls.findNodeID(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	input := &enqueueInput{PullRequestID: id}
+	if opts != nil {
+		input.ExpectedHeadOid = opts.SHA
+		input.Jump = opts.Jump
+	}
+	in := &graphqlInput{
+		Query:     enqueuePullRequestMutation,
+		Variables: map[string]interface{}{"input": input},
+	}
+	out := new(enqueueData)
+	res, err = s.client.graphql(ctx, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertMergeQueueEntry(&out.EnqueuePullRequest.MergeQueueEntry), res, nil
+}
+
+func (s *mergeQueueService) Remove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	pulls := &pullService{&issueService{s.client}}
+	id, res, err := pulls.findNodeID(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &graphqlInput{
+		Query:     dequeuePullRequestMutation,
+		Variables: map[string]interface{}{"input": map[string]string{"id": id}},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+const mergeQueueEntryFragment = `fragment mergeQueueEntryFields on MergeQueueEntry {
+  id
+  position
+  state
+  enqueuedAt
+  enqueuer {
+    login
+    avatarUrl
+  }
+  headCommit {
+    oid
+  }
+  pullRequest {
+    number
+    baseRefName
+  }
+}`
+
+const mergeQueueQuery = `query($owner: String!, $name: String!, $branch: String, $first: Int!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    mergeQueue(branch: $branch) {
+      entries(first: $first, after: $cursor) {
+        nodes {
+          ...mergeQueueEntryFields
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+      }
+    }
+  }
+}
+` + mergeQueueEntryFragment
+
+const enqueuePullRequestMutation = `mutation($input: EnqueuePullRequestInput!) {
+  enqueuePullRequest(input: $input) {
+    mergeQueueEntry {
+      ...mergeQueueEntryFields
+    }
+  }
+}
+` + mergeQueueEntryFragment
+
+const dequeuePullRequestMutation = `mutation($input: DequeuePullRequestInput!) {
+  dequeuePullRequest(input: $input) {
+    mergeQueueEntry {
+      id
+    }
+  }
+}`
+
+type enqueueInput struct {
+	PullRequestID   string `json:"pullRequestId"`
+	ExpectedHeadOid string `json:"expectedHeadOid,omitempty"`
+	Jump            bool   `json:"jump,omitempty"`
+}
+
+type mergeQueueEntry struct {
+	ID         string    `json:"id"`
+	Position   int       `json:"position"`
+	State      string    `json:"state"`
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+	Enqueuer   struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatarUrl"`
+	} `json:"enqueuer"`
+	HeadCommit struct {
+		Oid string `json:"oid"`
+	} `json:"headCommit"`
+	PullRequest struct {
+		Number      int    `json:"number"`
+		BaseRefName string `json:"baseRefName"`
+	} `json:"pullRequest"`
+}
+
+type mergeQueueData struct {
+	Repository struct {
+		MergeQueue *struct {
+			Entries struct {
+				Nodes    []*mergeQueueEntry `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"entries"`
+		} `json:"mergeQueue"`
+	} `json:"repository"`
+}
+
+type enqueueData struct {
+	EnqueuePullRequest struct {
+		MergeQueueEntry mergeQueueEntry `json:"mergeQueueEntry"`
+	} `json:"enqueuePullRequest"`
+}
+
+func convertMergeQueueEntryList(from []*mergeQueueEntry) []*scm.MergeQueueEntry {
+	to := []*scm.MergeQueueEntry{}
+	for _, v := range from {
+		to = append(to, convertMergeQueueEntry(v))
+	}
+	return to
+}
+
+func convertMergeQueueEntry(from *mergeQueueEntry) *scm.MergeQueueEntry {
+	return &scm.MergeQueueEntry{
+		ID:       from.ID,
+		Number:   from.PullRequest.Number,
+		Position: from.Position,
+		State:    strings.ToLower(from.State),
+		Sha:      from.HeadCommit.Oid,
+		BaseRef:  from.PullRequest.BaseRefName,
+		Author: scm.User{
+			Login:  from.Enqueuer.Login,
+			Avatar: from.Enqueuer.AvatarURL,
+		},
+		Created: from.EnqueuedAt,
+	}
+}
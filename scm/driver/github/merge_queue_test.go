@@ -0,0 +1,157 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestMergeQueueList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`mergeQueue\(branch: \$branch\).*"variables":{"branch":"master","first":30,"name":"hello-world","owner":"octocat"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_queue.json")
+
+	client := NewDefault()
+	got, res, err := client.MergeQueues.List(context.Background(), "octocat/hello-world", "master", scm.ListOptions{Page: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.MergeQueueEntry{}
+	raw, _ := ioutil.ReadFile("testdata/merge_queue.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if res.Page.Next != 2 {
+		t.Errorf("Want next page 2, got %d", res.Page.Next)
+	}
+	t.Run("Request", testRequest(res))
+}
+
+func TestMergeQueueListPage(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"variables":{"branch":"master","first":2,"name":"hello-world","owner":"octocat"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_queue.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`"variables":{"branch":"master","cursor":"Y3Vyc29yOnYyOpHOAAbce","first":2,"name":"hello-world","owner":"octocat"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_queue.json")
+
+	client := NewDefault()
+	got, res, err := client.MergeQueues.List(context.Background(), "octocat/hello-world", "master", scm.ListOptions{Page: 2, Size: 2})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 2 {
+		t.Errorf("Want 2 entries, got %d", len(got))
+	}
+	if res.Page.Next != 3 {
+		t.Errorf("Want next page 3, got %d", res.Page.Next)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestMergeQueueAdd(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`enqueuePullRequest.*"input":{"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ==","expectedHeadOid":"6dcb09b5b57875f334f61aebed695e2e4193db5e","jump":true}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_queue_add.json")
+
+	client := NewDefault()
+	opts := &scm.MergeQueueOptions{
+		SHA:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Jump: true,
+	}
+	got, res, err := client.MergeQueues.Add(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.MergeQueueEntry)
+	raw, _ := ioutil.ReadFile("testdata/merge_queue_add.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestMergeQueueRemove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`dequeuePullRequest.*"input":{"id":"MDExOlB1bGxSZXF1ZXN0MQ=="}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_queue_remove.json")
+
+	client := NewDefault()
+	res, err := client.MergeQueues.Remove(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+}
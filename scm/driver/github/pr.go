@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
-	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
 	errors2 "k8s.io/apimachinery/pkg/util/errors"
 )
 
@@ -39,6 +38,13 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequestList(out), res, err
 }
 
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/commits?%s", repo, number, encodeListOptions(opts))
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/files?%s", repo, number, encodeListOptions(opts))
 	out := []*file{}
@@ -52,6 +58,140 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int, option
 	return res, err
 }
 
+// UpdatePullRequestBranch merges the base branch into the pull
+// request branch. The rest api cannot rebase the branch, so a
+// rebase uses the GraphQL api instead.
+//
+// See https://docs.github.com/en/rest/pulls/pulls#update-a-pull-request-branch
+func (s *pullService) UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	in := new(updateBranchInput)
+	if opts != nil {
+		switch opts.Method {
+		case "", "merge":
+		case "rebase":
+			return s.rebaseBranch(ctx, repo, number, opts.SHA)
+		default:
+			return nil, scm.ErrNotSupported
+		}
+		in.ExpectedHeadSha = opts.SHA
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/update-branch", repo, number)
+	res, err := s.client.doUnprocessable(ctx, "PUT", path, in, nil)
+	// the update is rejected with an unprocessable entity if the
+	// head does not match the expected sha, or if the branch
+	// cannot be merged cleanly.
+	if res != nil && res.Status == http.StatusUnprocessableEntity {
+		if in.ExpectedHeadSha != "" && err != nil && strings.Contains(err.Error(), "expected head sha") {
+			return res, scm.ErrRefMismatch
+		}
+		return res, scm.MergeConflict{Message: fmt.Sprintf("cannot update the branch of pull request %d", number)}
+	}
+	return res, err
+}
+
+// RebasePullRequest rebases the pull request branch onto the base
+// branch, which is only supported by the GraphQL api.
+func (s *pullService) RebasePullRequest(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return s.rebaseBranch(ctx, repo, number, "")
+}
+
+func (s *pullService) rebaseBranch(ctx context.Context, repo string, number int, sha string) (*scm.Response, error) {
+	id, res, err := s.findNodeID(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &graphqlInput{
+		Query: updatePullRequestBranchMutation,
+		Variables: map[string]interface{}{"input": &updateBranchGraphqlInput{
+			PullRequestID:   id,
+			ExpectedHeadOid: sha,
+			UpdateMethod:    "REBASE",
+		}},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// EnableAutoMerge enables the auto-merge of the pull request, which
+// is only supported by the GraphQL api.
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, opts *scm.AutoMergeOptions) (*scm.Response, error) {
+	id, res, err := s.findNodeID(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	input := &autoMergeInput{PullRequestID: id}
+	if opts != nil {
+		input.MergeMethod = strings.ToUpper(opts.MergeMethod)
+		input.CommitHeadline = opts.CommitTitle
+		input.CommitBody = opts.CommitMessage
+		input.ExpectedHeadOid = opts.SHA
+	}
+	in := &graphqlInput{
+		Query:     enableAutoMergeMutation,
+		Variables: map[string]interface{}{"input": input},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// DisableAutoMerge disables the auto-merge of the pull request,
+// which is only supported by the GraphQL api.
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	id, res, err := s.findNodeID(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	in := &graphqlInput{
+		Query:     disableAutoMergeMutation,
+		Variables: map[string]interface{}{"input": &autoMergeInput{PullRequestID: id}},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// SetDraft converts the pull request to a draft, or marks it as
+// ready for review, which is only supported by the GraphQL api.
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	id, res, err := s.findNodeID(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	query := markReadyForReviewMutation
+	if draft {
+		query = convertToDraftMutation
+	}
+	in := &graphqlInput{
+		Query:     query,
+		Variables: map[string]interface{}{"input": map[string]string{"pullRequestId": id}},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// findNodeID returns the GraphQL node ID of the pull request.
+func (s *pullService) findNodeID(ctx context.Context, repo string, number int) (string, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out.NodeID, res, err
+}
+
+// findReviewDecision returns the review decision of the pull
+// request, which is only exposed by the GraphQL API.
+func (s *pullService) findReviewDecision(ctx context.Context, repo string, number int) (scm.ReviewDecision, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	in := &graphqlInput{
+		Query: reviewDecisionQuery,
+		Variables: map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"number": number,
+		},
+	}
+	out := new(reviewDecisionData)
+	res, err := s.client.graphql(ctx, in, out)
+	if err != nil {
+		return scm.ReviewDecisionUnknown, res, err
+	}
+	return convertReviewDecision(out.Repository.PullRequest.ReviewDecision), res, nil
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
 	data := map[string]string{"state": "closed"}
@@ -73,11 +213,17 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 		Head:  input.Head,
 		Base:  input.Base,
 		Body:  input.Body,
+		Draft: input.Draft,
+
+		MaintainerCanModify: input.MaintainerCanModify,
 	}
 
 	out := new(pr)
 	res, err := s.client.do(ctx, "POST", path, in, out)
-	return convertPullRequest(out), res, err
+	if err != nil {
+		return convertPullRequest(out), res, err
+	}
+	return s.setIssueFields(ctx, repo, convertPullRequest(out), res, input)
 }
 
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
@@ -95,10 +241,54 @@ func (s *pullService) Update(ctx context.Context, repo string, number int, input
 	if input.Base != "" {
 		in.Base = input.Base
 	}
+	in.MaintainerCanModify = input.MaintainerCanModify
 
 	out := new(pr)
 	res, err := s.client.do(ctx, "PATCH", path, in, out)
-	return convertPullRequest(out), res, err
+	if err != nil {
+		return convertPullRequest(out), res, err
+	}
+	return s.setIssueFields(ctx, repo, convertPullRequest(out), res, input)
+}
+
+// setIssueFields sets the labels, assignees and milestone, which the
+// pulls api does not accept, with a single call to the issues api,
+// and then requests the reviews.
+func (s *pullService) setIssueFields(ctx context.Context, repo string, to *scm.PullRequest, res *scm.Response, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	if len(input.Labels) != 0 || len(input.Assignees) != 0 || input.Milestone != 0 {
+		path := fmt.Sprintf("repos/%s/issues/%d", repo, to.Number)
+		in := &prIssueInput{
+			Labels:    input.Labels,
+			Assignees: input.Assignees,
+			Milestone: input.Milestone,
+		}
+		out := new(issue)
+		var err error
+		res, err = s.client.do(ctx, "PATCH", path, in, out)
+		if err != nil {
+			return to, res, err
+		}
+		from := convertIssue(out)
+		to.Labels = from.Labels
+		to.Assignees = from.Assignees
+		if from.Milestone != nil {
+			to.Milestone = *from.Milestone
+		}
+	}
+	if len(input.Reviewers) == 0 {
+		return to, res, nil
+	}
+	out, res, err := s.tryRequestReview(ctx, repo, to.Number, input.Reviewers)
+	if err != nil && res != nil && res.Status == http.StatusUnprocessableEntity {
+		// request the reviews individually to report the
+		// invalid users.
+		res, err = s.RequestReview(ctx, repo, to.Number, input.Reviewers)
+		return to, res, err
+	}
+	if err != nil {
+		return to, res, err
+	}
+	return out, res, nil
 }
 
 func (s *pullService) RequestReview(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
@@ -156,6 +346,34 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return res, nil
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &prReviewers{TeamReviewers: teams}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	in := &prReviewers{TeamReviewers: teams}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+// ListReviewers returns the users who reviewed the pull request,
+// followed by the users and teams whose review is still requested.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/requested_reviewers", repo, number)
+	requested := new(requestedReviewers)
+	res, err := s.client.do(ctx, "GET", path, nil, requested)
+	if err != nil {
+		return nil, res, err
+	}
+	reviews, res, err := (&reviewService{s.client}).listAll(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertReviewers(requested, reviews), res, nil
+}
+
 func prepareReviewersBody(logins []string, org string) (prReviewers, error) {
 	body := prReviewers{}
 	var errors []error
@@ -192,6 +410,11 @@ type prReviewers struct {
 	TeamReviewers []string `json:"team_reviewers,omitempty"`
 }
 
+type requestedReviewers struct {
+	Users []user  `json:"users"`
+	Teams []*team `json:"teams"`
+}
+
 type pullRequestMergeRequest struct {
 	CommitMessage string `json:"commit_message,omitempty"`
 	CommitTitle   string `json:"commit_title,omitempty"`
@@ -199,6 +422,16 @@ type pullRequestMergeRequest struct {
 	SHA           string `json:"sha,omitempty"`
 }
 
+type updateBranchInput struct {
+	ExpectedHeadSha string `json:"expected_head_sha,omitempty"`
+}
+
+type updateBranchGraphqlInput struct {
+	PullRequestID   string `json:"pullRequestId"`
+	ExpectedHeadOid string `json:"expectedHeadOid,omitempty"`
+	UpdateMethod    string `json:"updateMethod"`
+}
+
 type prBranch struct {
 	Ref  string     `json:"ref"`
 	Sha  string     `json:"sha"`
@@ -207,28 +440,97 @@ type prBranch struct {
 }
 
 type pr struct {
-	Number             int         `json:"number"`
-	State              string      `json:"state"`
-	Title              string      `json:"title"`
-	Body               string      `json:"body"`
-	Labels             []*label    `json:"labels"`
-	DiffURL            string      `json:"diff_url"`
-	HTMLURL            string      `json:"html_url"`
-	User               user        `json:"user"`
-	RequestedReviewers []user      `json:"requested_reviewers"`
-	Assignees          []user      `json:"assignees"`
-	Head               prBranch    `json:"head"`
-	Base               prBranch    `json:"base"`
-	Draft              bool        `json:"draft"`
-	Merged             bool        `json:"merged"`
-	Mergeable          bool        `json:"mergeable"`
-	MergeableState     string      `json:"mergeable_state"`
-	Rebaseable         bool        `json:"rebaseable"`
-	MergeSha           string      `json:"merge_commit_sha"`
-	Milestone          milestone   `json:"milestone"`
-	MergedAt           null.String `json:"merged_at"`
-	CreatedAt          time.Time   `json:"created_at"`
-	UpdatedAt          time.Time   `json:"updated_at"`
+	NodeID             string     `json:"node_id"`
+	Number             int        `json:"number"`
+	State              string     `json:"state"`
+	Title              string     `json:"title"`
+	Body               string     `json:"body"`
+	Labels             []*label   `json:"labels"`
+	DiffURL            string     `json:"diff_url"`
+	HTMLURL            string     `json:"html_url"`
+	User               user       `json:"user"`
+	RequestedReviewers []user     `json:"requested_reviewers"`
+	Assignees          []user     `json:"assignees"`
+	Head               prBranch   `json:"head"`
+	Base               prBranch   `json:"base"`
+	Draft              bool       `json:"draft"`
+	Merged             bool       `json:"merged"`
+	Mergeable          bool       `json:"mergeable"`
+	MergeableState     string     `json:"mergeable_state"`
+	Rebaseable         bool       `json:"rebaseable"`
+	MergeSha           string     `json:"merge_commit_sha"`
+	Milestone          milestone  `json:"milestone"`
+	MergedBy           *user      `json:"merged_by"`
+	MergedAt           *time.Time `json:"merged_at"`
+	ClosedAt           *time.Time `json:"closed_at"`
+	Commits            int        `json:"commits"`
+	Additions          int        `json:"additions"`
+	Deletions          int        `json:"deletions"`
+	ChangedFiles       int        `json:"changed_files"`
+	AutoMerge          *autoMerge `json:"auto_merge"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type autoMergeInput struct {
+	PullRequestID   string `json:"pullRequestId"`
+	MergeMethod     string `json:"mergeMethod,omitempty"`
+	CommitHeadline  string `json:"commitHeadline,omitempty"`
+	CommitBody      string `json:"commitBody,omitempty"`
+	ExpectedHeadOid string `json:"expectedHeadOid,omitempty"`
+}
+
+const enableAutoMergeMutation = `mutation($input: EnablePullRequestAutoMergeInput!) {
+  enablePullRequestAutoMerge(input: $input) {
+    clientMutationId
+  }
+}`
+
+const disableAutoMergeMutation = `mutation($input: DisablePullRequestAutoMergeInput!) {
+  disablePullRequestAutoMerge(input: $input) {
+    clientMutationId
+  }
+}`
+
+const updatePullRequestBranchMutation = `mutation($input: UpdatePullRequestBranchInput!) {
+  updatePullRequestBranch(input: $input) {
+    clientMutationId
+  }
+}`
+
+const reviewDecisionQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviewDecision
+    }
+  }
+}`
+
+type reviewDecisionData struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewDecision string `json:"reviewDecision"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+const markReadyForReviewMutation = `mutation($input: MarkPullRequestReadyForReviewInput!) {
+  markPullRequestReadyForReview(input: $input) {
+    clientMutationId
+  }
+}`
+
+const convertToDraftMutation = `mutation($input: ConvertPullRequestToDraftInput!) {
+  convertPullRequestToDraft(input: $input) {
+    clientMutationId
+  }
+}`
+
+type autoMerge struct {
+	EnabledBy     user   `json:"enabled_by"`
+	MergeMethod   string `json:"merge_method"`
+	CommitTitle   string `json:"commit_title"`
+	CommitMessage string `json:"commit_message"`
 }
 
 type file struct {
@@ -248,6 +550,15 @@ type prInput struct {
 	Body  string `json:"body,omitempty"`
 	Head  string `json:"head,omitempty"`
 	Base  string `json:"base,omitempty"`
+	Draft bool   `json:"draft,omitempty"`
+
+	MaintainerCanModify *bool `json:"maintainer_can_modify,omitempty"`
+}
+
+type prIssueInput struct {
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone int      `json:"milestone,omitempty"`
 }
 
 func convertPullRequestList(from []*pr) []*scm.PullRequest {
@@ -259,7 +570,7 @@ func convertPullRequestList(from []*pr) []*scm.PullRequest {
 }
 
 func convertPullRequest(from *pr) *scm.PullRequest {
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number:         from.Number,
 		Title:          from.Title,
 		Body:           from.Body,
@@ -284,9 +595,28 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Author:         *convertUser(&from.User),
 		Assignees:      convertUsers(from.Assignees),
 		Reviewers:      convertUsers(from.RequestedReviewers),
+		MergedAt:       from.MergedAt,
+		ClosedAt:       from.ClosedAt,
+		Commits:        from.Commits,
+		Additions:      from.Additions,
+		Deletions:      from.Deletions,
+		ChangedFiles:   from.ChangedFiles,
+		FromFork:       from.Head.Repo.FullName != "" && from.Head.Repo.FullName != from.Base.Repo.FullName,
 		Created:        from.CreatedAt,
 		Updated:        from.UpdatedAt,
 	}
+	if from.MergedBy != nil {
+		to.MergedBy = convertUser(from.MergedBy)
+	}
+	if from.AutoMerge != nil {
+		to.AutoMerge = &scm.AutoMerge{
+			EnabledBy:     *convertUser(&from.AutoMerge.EnabledBy),
+			MergeMethod:   from.AutoMerge.MergeMethod,
+			CommitTitle:   from.AutoMerge.CommitTitle,
+			CommitMessage: from.AutoMerge.CommitMessage,
+		}
+	}
+	return to
 }
 
 func convertPullRequestBranch(src *prBranch) *scm.PullRequestBranch {
@@ -297,6 +627,50 @@ func convertPullRequestBranch(src *prBranch) *scm.PullRequestBranch {
 	}
 }
 
+// convertReviewers returns the reviewers in order of their first
+// review. A comment does not replace an earlier approval, or
+// request for changes, and a user whose review is requested again
+// is pending.
+func convertReviewers(requested *requestedReviewers, reviews []*scm.Review) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	byLogin := map[string]*scm.Reviewer{}
+	for _, r := range reviews {
+		// pending reviews have not been submitted yet
+		if r.State == scm.ReviewStatePending {
+			continue
+		}
+		reviewer, ok := byLogin[r.Author.Login]
+		if !ok {
+			reviewer = &scm.Reviewer{Kind: scm.ReviewerKindUser, User: r.Author}
+			byLogin[r.Author.Login] = reviewer
+			to = append(to, reviewer)
+		}
+		if r.State != scm.ReviewStateCommented || reviewer.State == "" {
+			reviewer.State = r.State
+		}
+		reviewer.Reviewed = r.Created
+	}
+	for _, u := range requested.Users {
+		if reviewer, ok := byLogin[u.Login]; ok {
+			reviewer.State = scm.ReviewStatePending
+			continue
+		}
+		to = append(to, &scm.Reviewer{
+			Kind:  scm.ReviewerKindUser,
+			User:  *convertUser(&u),
+			State: scm.ReviewStatePending,
+		})
+	}
+	for _, t := range requested.Teams {
+		to = append(to, &scm.Reviewer{
+			Kind:  scm.ReviewerKindTeam,
+			Team:  *convertTeam(t),
+			State: scm.ReviewStatePending,
+		})
+	}
+	return to
+}
+
 func convertUsers(users []user) []scm.User {
 	answer := []scm.User{}
 	for _, u := range users {
@@ -334,3 +708,16 @@ func convertChange(from *file) *scm.Change {
 		Sha:          from.Sha,
 	}
 }
+
+func convertReviewDecision(from string) scm.ReviewDecision {
+	switch from {
+	case "APPROVED":
+		return scm.ReviewDecisionApproved
+	case "CHANGES_REQUESTED":
+		return scm.ReviewDecisionChangesRequested
+	case "REVIEW_REQUIRED":
+		return scm.ReviewDecisionReviewRequired
+	default:
+		return scm.ReviewDecisionUnknown
+	}
+}
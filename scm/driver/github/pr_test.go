@@ -81,6 +81,40 @@ func TestPullList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestPullListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347/commits").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/commits.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListCommits(context.Background(), "octocat/hello-world", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
 func TestPullListChanges(t *testing.T) {
 	gock.New("https://api.github.com").
 		Get("/repos/octocat/hello-world/pulls/1347/files").
@@ -137,6 +171,217 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullEnableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`enablePullRequestAutoMerge.*"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ==","mergeMethod":"SQUASH","commitHeadline":"Add a feature"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_auto_merge.json")
+
+	client := NewDefault()
+	opts := &scm.AutoMergeOptions{
+		MergeMethod: "squash",
+		CommitTitle: "Add a feature",
+	}
+	res, err := client.PullRequests.EnableAutoMerge(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestPullEnableAutoMergeError(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_auto_merge_error.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "octocat/hello-world", 1347, nil)
+	if err == nil {
+		t.Errorf("Expect error when auto-merge is not allowed")
+		return
+	}
+	if got, want := err.Error(), "Pull request Auto merge is not allowed for this repository"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
+func TestPullDisableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`disablePullRequestAutoMerge.*"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ=="`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_auto_merge_disable.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.DisableAutoMerge(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullUpdateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/update-branch").
+		JSON(map[string]string{"expected_head_sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"}).
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"Updating pull request branch."}`)
+
+	client := NewDefault()
+	opts := &scm.PullRequestUpdateBranchOptions{SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	res, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdateBranch_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/update-branch").
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"merge conflict between base and head"}`)
+
+	client := NewDefault()
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "octocat/hello-world", 1347, nil)
+	if !scm.IsMergeConflict(err) {
+		t.Errorf("Want merge conflict error, got %v", err)
+	}
+}
+
+func TestPullUpdateBranch_ShaMismatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Put("/repos/octocat/hello-world/pulls/1347/update-branch").
+		Reply(422).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"message":"expected head sha didn't match current head ref."}`)
+
+	client := NewDefault()
+	opts := &scm.PullRequestUpdateBranchOptions{SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != scm.ErrRefMismatch {
+		t.Errorf("Want ErrRefMismatch, got %v", err)
+	}
+}
+
+func TestPullUpdateBranch_Rebase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`updatePullRequestBranch.*"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ==","expectedHeadOid":"6dcb09b5b57875f334f61aebed695e2e4193db5e","updateMethod":"REBASE"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_update_branch.json")
+
+	client := NewDefault()
+	opts := &scm.PullRequestUpdateBranchOptions{Method: "rebase", SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "octocat/hello-world", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullRebase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`updatePullRequestBranch.*"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ==","updateMethod":"REBASE"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_update_branch.json")
+
+	client := NewDefault()
+	res, err := client.PullRequests.RebasePullRequest(context.Background(), "octocat/hello-world", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -216,6 +461,83 @@ func TestPullCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullCreateDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls").
+		JSON(map[string]interface{}{
+			"title": "Amazing new feature",
+			"head":  "octocat:new-feature",
+			"base":  "master",
+			"draft": true,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	input := &scm.PullRequestInput{
+		Title: "Amazing new feature",
+		Head:  "octocat:new-feature",
+		Base:  "master",
+		Draft: true,
+	}
+
+	client := NewDefault()
+	_, res, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+}
+
+func TestPullSetDraft(t *testing.T) {
+	tests := []struct {
+		draft    bool
+		mutation string
+		file     string
+	}{
+		{draft: true, mutation: "convertPullRequestToDraft", file: "testdata/pr_convert_to_draft.json"},
+		{draft: false, mutation: "markPullRequestReadyForReview", file: "testdata/pr_ready_for_review.json"},
+	}
+	for _, test := range tests {
+		t.Run(test.mutation, func(t *testing.T) {
+			defer gock.Off()
+
+			gock.New("https://api.github.com").
+				Get("/repos/octocat/hello-world/pulls/1347").
+				Reply(200).
+				Type("application/json").
+				SetHeaders(mockHeaders).
+				File("testdata/pr.json")
+
+			gock.New("https://api.github.com").
+				Post("/graphql").
+				BodyString(test.mutation + `.*"input":{"pullRequestId":"MDExOlB1bGxSZXF1ZXN0MQ=="}`).
+				Reply(200).
+				Type("application/json").
+				SetHeaders(mockHeaders).
+				File(test.file)
+
+			client := NewDefault()
+			res, err := client.PullRequests.SetDraft(context.Background(), "octocat/hello-world", 1347, test.draft)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !gock.IsDone() {
+				t.Errorf("Pending mocks")
+			}
+
+			t.Run("Request", testRequest(res))
+		})
+	}
+}
+
 func TestPullUpdate(t *testing.T) {
 	defer gock.Off()
 
@@ -252,6 +574,192 @@ func TestPullUpdate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullCreateWithFields(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls").
+		JSON(map[string]interface{}{
+			"title":                 "Amazing new feature",
+			"head":                  "hubot:new-feature",
+			"base":                  "master",
+			"maintainer_can_modify": false,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1347").
+		JSON(map[string]interface{}{
+			"labels":    []string{"bug"},
+			"assignees": []string{"octocat"},
+			"milestone": 1,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/requested_reviewers").
+		JSON(map[string]interface{}{
+			"reviewers":      []string{"hubot"},
+			"team_reviewers": []string{"justice-league"},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	maintainerCanModify := false
+	input := &scm.PullRequestInput{
+		Title:               "Amazing new feature",
+		Head:                "hubot:new-feature",
+		Base:                "master",
+		Labels:              []string{"bug"},
+		Assignees:           []string{"octocat"},
+		Reviewers:           []string{"hubot", "octocat/justice-league"},
+		Milestone:           1,
+		MaintainerCanModify: &maintainerCanModify,
+	}
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.Create(context.Background(), "octocat/hello-world", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	want := new(scm.PullRequest)
+	raw, _ := ioutil.ReadFile("testdata/pr.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUpdateLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/pulls/1347").
+		JSON(map[string]interface{}{}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/issues/1347").
+		JSON(map[string]interface{}{"labels": []string{"bug"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	client := NewDefault()
+	input := &scm.PullRequestInput{Labels: []string{"bug"}}
+	got, _, err := client.PullRequests.Update(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+	if len(got.Labels) != 1 || got.Labels[0].Name != "bug" {
+		t.Errorf("Want the labels of the issue, got %v", got.Labels)
+	}
+}
+
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1/requested_reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_requested_reviewers.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1/reviews").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_reviewers_reviews.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListReviewers(context.Background(), "octocat/hello-world", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRequestTeamReview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1/requested_reviewers").
+		JSON(map[string]interface{}{"team_reviewers": []string{"justice-league"}}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	client := NewDefault()
+	res, err := client.PullRequests.RequestTeamReview(context.Background(), "octocat/hello-world", 1, []string{"justice-league"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullUnrequestTeamReview(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/pulls/1/requested_reviewers").
+		JSON(map[string]interface{}{"team_reviewers": []string{"justice-league"}}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	client := NewDefault()
+	res, err := client.PullRequests.UnrequestTeamReview(context.Background(), "octocat/hello-world", 1, []string{"justice-league"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullService_RequestReview(t *testing.T) {
 	defer gock.Off()
 
@@ -0,0 +1,136 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// reactionService implements the reactions. Pull requests are
+// issues and the pull request comments are issue comments.
+//
+// See https://docs.github.com/en/rest/reactions/reactions
+type reactionService struct {
+	client *wrapper
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number), opts)
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number), emoji)
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("repos/%s/issues/%d/reactions", repo, number), emoji)
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id), opts)
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id), emoji)
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("repos/%s/issues/comments/%d/reactions", repo, id), emoji)
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueReactions(ctx, repo, number, opts)
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueReaction(ctx, repo, number, emoji)
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.ListIssueCommentReactions(ctx, repo, number, id, opts)
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.CreateIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.DeleteIssueCommentReaction(ctx, repo, number, id, emoji)
+}
+
+func (s *reactionService) list(ctx context.Context, path string, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path = fmt.Sprintf("%s?%s", path, encodeListOptions(opts))
+	out := []*reaction{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReactionList(out), res, err
+}
+
+func (s *reactionService) create(ctx context.Context, path string, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	in := &reactionInput{Content: emoji.String()}
+	out := new(reaction)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReaction(out), res, err
+}
+
+// delete deletes the reactions of the authenticated user with the
+// given emoji, which are found by listing the reactions.
+func (s *reactionService) delete(ctx context.Context, path string, emoji scm.Emoji) (*scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return res, err
+	}
+	params := url.Values{}
+	params.Set("content", emoji.String())
+	params.Set("per_page", "100")
+	out := []*reaction{}
+	res, err = s.client.do(ctx, "GET", path+"?"+params.Encode(), nil, &out)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out {
+		if v.User.Login != user.Login {
+			continue
+		}
+		res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, v.ID), nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type reaction struct {
+	ID      int       `json:"id"`
+	User    user      `json:"user"`
+	Content string    `json:"content"`
+	Created time.Time `json:"created_at"`
+}
+
+type reactionInput struct {
+	Content string `json:"content"`
+}
+
+func convertReactionList(from []*reaction) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from {
+		to = append(to, convertReaction(v))
+	}
+	return to
+}
+
+func convertReaction(from *reaction) *scm.Reaction {
+	return &scm.Reaction{
+		ID:      from.ID,
+		Emoji:   scm.ToEmoji(from.Content),
+		Content: from.Content,
+		User:    *convertUser(&from.User),
+		Created: from.Created,
+	}
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestReactionListIssue(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/reactions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/reactions.json")
+
+	client := NewDefault()
+	got, res, err := client.Reactions.ListIssueReactions(context.Background(), "octocat/hello-world", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Reaction{}
+	raw, _ := ioutil.ReadFile("testdata/reactions.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestReactionCreatePullRequestComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/issues/comments/10/reactions").
+		BodyString(`{"content":"eyes"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reaction.json")
+
+	client := NewDefault()
+	got, res, err := client.Reactions.CreatePullRequestCommentReaction(context.Background(), "octocat/hello-world", 1347, 10, scm.EmojiEyes)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.ID != 1 || got.Emoji != scm.EmojiEyes || got.User.Login != "octocat" {
+		t.Errorf("Unexpected reaction %+v", got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReactionDeleteIssue(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/issues/1347/reactions").
+		MatchParam("content", "eyes").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/reactions.json")
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/issues/1347/reactions/1").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Reactions.DeleteIssueReaction(context.Background(), "octocat/hello-world", 1347, scm.EmojiEyes)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
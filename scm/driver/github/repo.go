@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -46,6 +48,13 @@ type repositoryInput struct {
 	Private     bool   `json:"private"`
 }
 
+type labelInput struct {
+	Name        string `json:"name,omitempty"`
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
 type hook struct {
 	ID     int      `json:"id,omitempty"`
 	Name   string   `json:"name"`
@@ -252,6 +261,37 @@ func (s *repositoryService) ListLabels(ctx context.Context, repo string, opts sc
 	return convertLabelObjects(out), res, err
 }
 
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels", repo)
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabelObject(out), res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	in := &labelInput{
+		Color:       strings.TrimPrefix(input.Color, "#"),
+		Description: input.Description,
+	}
+	if input.Name != name {
+		in.NewName = input.Name
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabelObject(out), res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/labels/%s", repo, url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 // Create creates a new repository
 func (s *repositoryService) Create(ctx context.Context, input *scm.RepositoryInput) (*scm.Repository, *scm.Response, error) {
 	path := "user/repos"
@@ -338,6 +378,12 @@ func (s *repositoryService) Delete(ctx context.Context, repo string) (*scm.Respo
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) SetDefaultBranch(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s", repo)
+	in := map[string]string{"default_branch": branch}
+	return s.client.do(ctx, "PATCH", path, in, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -355,6 +355,28 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositorySetDefaultBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world").
+		JSON(map[string]string{"default_branch": "develop"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	res, err := client.Repositories.SetDefaultBranch(context.Background(), "octocat/hello-world", "develop")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestRepositoryHookCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -710,3 +732,90 @@ func TestRepositoryService_IsCollaborator_True(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestRepositoryCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/labels").
+		BodyString(`{"name":"bug","color":"f29513","description":"Something isn't working"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	in := &scm.LabelInput{
+		Name:        "bug",
+		Color:       "#f29513",
+		Description: "Something isn't working",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.CreateLabel(context.Background(), "octocat/hello-world", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Patch("/repos/octocat/hello-world/labels/bug report").
+		BodyString(`{"new_name":"bug","color":"f29513"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.UpdateLabel(context.Background(), "octocat/hello-world", "bug report", &scm.LabelInput{Name: "bug", Color: "f29513"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.Name != "bug" {
+		t.Errorf("Want label name bug, got %s", got.Name)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Delete("/repos/octocat/hello-world/labels/bug").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteLabel(context.Background(), "octocat/hello-world", "bug")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
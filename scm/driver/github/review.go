@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -30,6 +31,23 @@ func (s *reviewService) List(ctx context.Context, repo string, number int, opts
 	return convertReviewList(out), res, err
 }
 
+// listAll returns the reviews of all the pages.
+func (s *reviewService) listAll(ctx context.Context, repo string, number int) ([]*scm.Review, *scm.Response, error) {
+	var reviews []*scm.Review
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		page, res, err := s.List(ctx, repo, number, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		reviews = append(reviews, page...)
+		if res.Page.Next == 0 {
+			return reviews, res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
 	path := fmt.Sprintf("repos/%s/pulls/%d/reviews", repo, number)
 	in := &reviewInput{
@@ -38,11 +56,7 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 		Event:    input.Event,
 	}
 	for _, c := range input.Comments {
-		in.Comments = append(in.Comments, &reviewCommentInput{
-			Body:     c.Body,
-			Path:     c.Path,
-			Position: c.Line,
-		})
+		in.Comments = append(in.Comments, convertReviewCommentInput(c))
 	}
 	out := new(review)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -96,12 +110,140 @@ func (s *reviewService) Dismiss(ctx context.Context, repo string, prID int, revi
 	return convertReview(out), res, err
 }
 
+// CreateComment creates a review comment on the head commit of
+// the pull request.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.ReviewComment, *scm.Response, error) {
+	pr, res, err := s.client.PullRequests.Find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments", repo, number)
+	in := convertReviewCommentInput(input)
+	in.CommitID = pr.Head.Sha
+	out := new(reviewComment)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out), res, err
+}
+
+func (s *reviewService) ReplyToComment(ctx context.Context, repo string, number, id int, body string) (*scm.ReviewComment, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/comments/%d/replies", repo, number, id)
+	in := &reviewUpdateInput{Body: body}
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out), res, err
+}
+
+// ResolveThread resolves a review thread using the GraphQL API. The
+// thread is either the ID of its first comment, as in the ThreadID
+// of the review comments, or the node ID of the thread.
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	threadID := thread
+	if commentID, err := strconv.Atoi(thread); err == nil {
+		var res *scm.Response
+		threadID, res, err = s.findThread(ctx, repo, number, commentID)
+		if err != nil {
+			return res, err
+		}
+	}
+	in := &graphqlInput{
+		Query:     resolveReviewThreadMutation,
+		Variables: map[string]interface{}{"threadId": threadID},
+	}
+	return s.client.graphql(ctx, in, nil)
+}
+
+// findThread returns the node ID of the review thread starting with
+// the given comment.
+func (s *reviewService) findThread(ctx context.Context, repo string, number, commentID int) (string, *scm.Response, error) {
+	owner, name := scm.Split(repo)
+	in := &graphqlInput{
+		Query: reviewThreadsQuery,
+		Variables: map[string]interface{}{
+			"owner":  owner,
+			"name":   name,
+			"number": number,
+		},
+	}
+	for {
+		out := new(reviewThreadsData)
+		res, err := s.client.graphql(ctx, in, out)
+		if err != nil {
+			return "", res, err
+		}
+		threads := out.Repository.PullRequest.ReviewThreads
+		for _, thread := range threads.Nodes {
+			if len(thread.Comments.Nodes) != 0 && thread.Comments.Nodes[0].DatabaseID == commentID {
+				return thread.ID, res, nil
+			}
+		}
+		if !threads.PageInfo.HasNextPage {
+			return "", res, scm.ErrNotFound
+		}
+		in.Variables["cursor"] = threads.PageInfo.EndCursor
+	}
+}
+
+const reviewThreadsQuery = `query($owner: String!, $name: String!, $number: Int!, $cursor: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $cursor) {
+        nodes {
+          id
+          comments(first: 1) {
+            nodes {
+              databaseId
+            }
+          }
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+      }
+    }
+  }
+}`
+
+const resolveReviewThreadMutation = `mutation($threadId: ID!) {
+  resolveReviewThread(input: {threadId: $threadId}) {
+    thread {
+      id
+    }
+  }
+}`
+
+type reviewThreadsData struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				Nodes []struct {
+					ID       string `json:"id"`
+					Comments struct {
+						Nodes []struct {
+							DatabaseID int `json:"databaseId"`
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
 type reviewComment struct {
-	ID       int    `json:"id"`
-	CommitID string `json:"commit_id"`
-	Position int    `json:"position"`
-	Path     string `json:"path"`
-	User     struct {
+	ID          int    `json:"id"`
+	CommitID    string `json:"commit_id"`
+	Position    int    `json:"position"`
+	Line        int    `json:"line"`
+	StartLine   int    `json:"start_line"`
+	Side        string `json:"side"`
+	StartSide   string `json:"start_side"`
+	InReplyToID int    `json:"in_reply_to_id"`
+	Path        string `json:"path"`
+	User        struct {
 		ID        int    `json:"id"`
 		Login     string `json:"login"`
 		AvatarURL string `json:"avatar_url"`
@@ -120,9 +262,14 @@ type reviewInput struct {
 }
 
 type reviewCommentInput struct {
-	Body     string `json:"body"`
-	Path     string `json:"path"`
-	Position int    `json:"position"`
+	Body      string `json:"body"`
+	CommitID  string `json:"commit_id,omitempty"`
+	Path      string `json:"path"`
+	Position  int    `json:"position,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
 }
 
 type reviewUpdateInput struct {
@@ -184,18 +331,65 @@ func convertReviewCommentList(from []*reviewComment) []*scm.ReviewComment {
 }
 
 func convertReviewComment(from *reviewComment) *scm.ReviewComment {
+	thread := from.ID
+	if from.InReplyToID != 0 {
+		thread = from.InReplyToID
+	}
+	// the line of a single-line comment is the position in the
+	// diff, for compatibility.
+	line := from.Position
+	if from.StartLine != 0 {
+		line = from.Line
+	}
 	return &scm.ReviewComment{
-		ID:   0,
-		Body: from.Body,
-		Path: from.Path,
-		Sha:  from.CommitID,
-		Line: from.Position,
-		Link: from.HTMLURL,
+		ID:        from.ID,
+		Body:      from.Body,
+		Path:      from.Path,
+		Sha:       from.CommitID,
+		Line:      line,
+		StartLine: from.StartLine,
+		Side:      from.Side,
+		StartSide: from.StartSide,
+		Position:  from.Position,
+		Link:      from.HTMLURL,
 		Author: scm.User{
 			Login:  from.User.Login,
 			Avatar: from.User.AvatarURL,
 		},
-		Created: from.CreatedAt,
-		Updated: from.UpdatedAt,
+		Created:   from.CreatedAt,
+		Updated:   from.UpdatedAt,
+		InReplyTo: from.InReplyToID,
+		ThreadID:  strconv.Itoa(thread),
 	}
 }
+
+// convertReviewCommentInput converts the review comment input. The
+// line is sent as the position in the diff if neither the side nor
+// the start line is set, for compatibility. Otherwise the comment is
+// placed by line, on the right side of the diff by default.
+func convertReviewCommentInput(from *scm.ReviewCommentInput) *reviewCommentInput {
+	to := &reviewCommentInput{
+		Body:     scm.FormatSuggestion(from.Body, from.Suggestion, "suggestion"),
+		Path:     from.Path,
+		Position: from.Position,
+	}
+	switch {
+	case to.Position != 0:
+	case from.Side == "" && from.StartLine == 0:
+		to.Position = from.Line
+	default:
+		to.Line = from.Line
+		to.Side = from.Side
+		if to.Side == "" {
+			to.Side = "RIGHT"
+		}
+		if from.StartLine != 0 {
+			to.StartLine = from.StartLine
+			to.StartSide = from.StartSide
+			if to.StartSide == "" {
+				to.StartSide = to.Side
+			}
+		}
+	}
+	return to
+}
@@ -273,3 +273,150 @@ func TestReviewDismiss(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/pulls/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr.json")
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/comments").
+		File("testdata/review_comment_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_comment.json")
+
+	input := &scm.ReviewCommentInput{
+		Body:       "Use a constant.",
+		Path:       "main.go",
+		Line:       12,
+		StartLine:  10,
+		Side:       scm.SideRight,
+		StartSide:  scm.SideRight,
+		Suggestion: "const answer = 42\n",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Reviews.CreateComment(context.Background(), "octocat/hello-world", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewComment)
+	raw, _ := ioutil.ReadFile("testdata/review_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewReplyToComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/pulls/1347/comments/12/replies").
+		BodyString(`{"body":"Done."}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_comment_reply.json")
+
+	client := NewDefault()
+	got, res, err := client.Reviews.ReplyToComment(context.Background(), "octocat/hello-world", 1347, 12, "Done.")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.ID != 13 || got.InReplyTo != 12 || got.ThreadID != "12" {
+		t.Errorf("Unexpected reply %+v", got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`reviewThreads`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_threads.json")
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`resolveReviewThread.*"threadId":"PRRT_kwDOAAABc84AAAAC"`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/resolve_thread.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.ResolveThread(context.Background(), "octocat/hello-world", 1347, "12")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewResolveThread_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/review_threads.json")
+
+	client := NewDefault()
+	_, err := client.Reviews.ResolveThread(context.Background(), "octocat/hello-world", 1347, "11")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestConvertReviewCommentInput(t *testing.T) {
+	tests := []struct {
+		in   *scm.ReviewCommentInput
+		want *reviewCommentInput
+	}{
+		{
+			in:   &scm.ReviewCommentInput{Path: "file.md", Line: 6},
+			want: &reviewCommentInput{Path: "file.md", Position: 6},
+		},
+		{
+			in:   &scm.ReviewCommentInput{Path: "file.md", Line: 6, Side: "LEFT"},
+			want: &reviewCommentInput{Path: "file.md", Line: 6, Side: "LEFT"},
+		},
+		{
+			in:   &scm.ReviewCommentInput{Path: "file.md", Line: 12, StartLine: 10},
+			want: &reviewCommentInput{Path: "file.md", Line: 12, Side: "RIGHT", StartLine: 10, StartSide: "RIGHT"},
+		},
+	}
+	for _, test := range tests {
+		if diff := cmp.Diff(convertReviewCommentInput(test.in), test.want); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
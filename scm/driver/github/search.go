@@ -0,0 +1,189 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// mediaTypeTextMatch requests the text fragments matching the
+// search terms.
+const mediaTypeTextMatch = "application/vnd.github.text-match+json"
+
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCode, *scm.Response, error) {
+	req := &scm.Request{
+		Method: "GET",
+		Path:   "search/code?" + encodeSearchQueryOptions(opts),
+		Header: map[string][]string{
+			"Accept": {mediaTypeTextMatch},
+		},
+	}
+	out := new(codeSearchResults)
+	res, err := s.client.doRequest(ctx, req, nil, out)
+	return convertSearchCodeList(out.Items), res, err
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCommit, *scm.Response, error) {
+	path := "search/commits?" + encodeSearchQueryOptions(opts)
+	out := new(commitSearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSearchCommitList(out.Items), res, err
+}
+
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.Repository, *scm.Response, error) {
+	path := "search/repositories?" + encodeSearchQueryOptions(opts)
+	out := new(repositorySearchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertRepositoryList(out.Items), res, err
+}
+
+func (s *searchService) PullRequests(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	path := "search/issues?" + encodeSearchQueryOptions(opts, "is:pr")
+	out := new(searchResults)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertSearchPullRequestList(out.Items), res, err
+}
+
+// encodeSearchQueryOptions encodes the search options, adding the
+// repository and organization qualifiers to the search terms.
+func encodeSearchQueryOptions(opts scm.SearchQueryOptions, qualifiers ...string) string {
+	var terms []string
+	if opts.Query != "" {
+		terms = append(terms, opts.Query)
+	}
+	if opts.Repo != "" {
+		terms = append(terms, "repo:"+opts.Repo)
+	}
+	if opts.Org != "" {
+		terms = append(terms, "org:"+opts.Org)
+	}
+	terms = append(terms, qualifiers...)
+
+	params := url.Values{}
+	params.Set("q", strings.Join(terms, " "))
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+		if opts.Ascending {
+			params.Set("order", "asc")
+		} else {
+			params.Set("order", "desc")
+		}
+	}
+	return params.Encode()
+}
+
+type codeSearchResults struct {
+	TotalCount int           `json:"total_count"`
+	Items      []*codeResult `json:"items"`
+}
+
+type codeResult struct {
+	Name        string     `json:"name"`
+	Path        string     `json:"path"`
+	Sha         string     `json:"sha"`
+	HTMLURL     string     `json:"html_url"`
+	Repository  repository `json:"repository"`
+	TextMatches []struct {
+		Fragment string `json:"fragment"`
+	} `json:"text_matches"`
+}
+
+type commitSearchResults struct {
+	TotalCount int             `json:"total_count"`
+	Items      []*commitResult `json:"items"`
+}
+
+type commitResult struct {
+	commit
+	Repository repository `json:"repository"`
+}
+
+type repositorySearchResults struct {
+	TotalCount int           `json:"total_count"`
+	Items      []*repository `json:"items"`
+}
+
+func convertSearchCodeList(from []*codeResult) []*scm.SearchCode {
+	to := []*scm.SearchCode{}
+	for _, v := range from {
+		to = append(to, convertSearchCode(v))
+	}
+	return to
+}
+
+func convertSearchCode(from *codeResult) *scm.SearchCode {
+	to := &scm.SearchCode{
+		Repository: *convertRepository(&from.Repository),
+		Path:       from.Path,
+		Sha:        from.Sha,
+		Link:       from.HTMLURL,
+	}
+	for _, match := range from.TextMatches {
+		to.Fragments = append(to.Fragments, &scm.SearchFragment{
+			Text: match.Fragment,
+		})
+	}
+	return to
+}
+
+func convertSearchCommitList(from []*commitResult) []*scm.SearchCommit {
+	to := []*scm.SearchCommit{}
+	for _, v := range from {
+		to = append(to, &scm.SearchCommit{
+			Commit:     *convertCommit(&v.commit),
+			Repository: *convertRepository(&v.Repository),
+		})
+	}
+	return to
+}
+
+func convertSearchPullRequestList(from []*searchIssue) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		to = append(to, convertSearchPullRequest(v))
+	}
+	return to
+}
+
+// convertSearchPullRequest converts the issue returned by the
+// search to a pull request. The branches are not included in
+// the search results.
+func convertSearchPullRequest(from *searchIssue) *scm.PullRequest {
+	issue := convertIssue(&from.issue)
+	to := &scm.PullRequest{
+		Number:    issue.Number,
+		Title:     issue.Title,
+		Body:      issue.Body,
+		Labels:    issue.Labels,
+		State:     issue.State,
+		Closed:    issue.Closed,
+		Author:    issue.Author,
+		Assignees: issue.Assignees,
+		Created:   issue.Created,
+		Updated:   issue.Updated,
+		Link:      issue.Link,
+	}
+	if issue.Milestone != nil {
+		to.Milestone = *issue.Milestone
+	}
+	populateRepositoryFromURL(&to.Base.Repo, from.RepositoryURL)
+	to.Base.Repo.FullName = scm.Join(to.Base.Repo.Namespace, to.Base.Repo.Name)
+	return to
+}
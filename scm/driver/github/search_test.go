@@ -0,0 +1,149 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestSearchCode(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/code").
+		MatchParam("q", "github.com/pkg/errors org:octocat").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		MatchHeader("Accept", "application/vnd.github.text-match\\+json").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/search_code.json")
+
+	client := NewDefault()
+	opts := scm.SearchQueryOptions{Query: "github.com/pkg/errors", Org: "octocat", Page: 1, Size: 30}
+	got, res, err := client.Search.Code(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.SearchCode{}
+	raw, _ := ioutil.ReadFile("testdata/search_code.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestSearchCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/commits").
+		MatchParam("q", "pkg/errors repo:octocat/Hello-World").
+		MatchParam("sort", "committer-date").
+		MatchParam("order", "asc").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_commits.json")
+
+	client := NewDefault()
+	opts := scm.SearchQueryOptions{Query: "pkg/errors", Repo: "octocat/Hello-World", Sort: "committer-date", Ascending: true}
+	got, res, err := client.Search.Commits(context.Background(), opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.SearchCommit{}
+	raw, _ := ioutil.ReadFile("testdata/search_commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSearchRepositories(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/repositories").
+		MatchParam("q", "hello org:octocat").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/search_repos.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.Repositories(context.Background(), scm.SearchQueryOptions{Query: "hello", Org: "octocat"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Repository{}
+	raw, _ := ioutil.ReadFile("testdata/search_repos.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestSearchPullRequests(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/search/issues").
+		MatchParam("q", "bump org:jenkins-x is:pr").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_search_prs.json")
+
+	client := NewDefault()
+	got, res, err := client.Search.PullRequests(context.Background(), scm.SearchQueryOptions{Query: "bump", Org: "jenkins-x"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/search_pulls.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
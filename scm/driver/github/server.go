@@ -0,0 +1,35 @@
+package github
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+// Find returns the server details. GitHub.com is not versioned, while
+// GitHub Enterprise Server reports its version from the meta endpoint.
+//
+// See https://docs.github.com/en/enterprise-server/rest/meta
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	if s.client.BaseURL.Host == "api.github.com" {
+		return &scm.ServerInfo{Product: scm.DriverGithub.String(), Edition: scm.EditionCloud}, nil, nil
+	}
+	out := new(meta)
+	res, err := s.client.do(ctx, "GET", "meta", nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.ServerInfo{
+		Product: scm.DriverGithub.String(),
+		Version: out.InstalledVersion,
+		Edition: scm.EditionEnterprise,
+	}, res, nil
+}
+
+type meta struct {
+	InstalledVersion string `json:"installed_version"`
+}
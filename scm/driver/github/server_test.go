@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestServerFind(t *testing.T) {
+	client := NewDefault()
+	got, _, err := client.Server.Find(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &scm.ServerInfo{Product: "github", Edition: scm.EditionCloud}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestServerFindEnterprise(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://github.example.com").
+		Get("/api/v3/meta").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/meta.json")
+
+	client, _ := New("https://github.example.com/api/v3")
+	got, err := client.ServerInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := new(scm.ServerInfo)
+	raw, _ := ioutil.ReadFile("testdata/meta.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	// the server details are cached on the client
+	if _, err := client.ServerInfo(context.Background()); err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -31,6 +31,24 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	info := &scm.TokenInfo{
+		User:   user,
+		Scopes: res.Scopes,
+	}
+	// classic personal access tokens and OAuth tokens report
+	// their scopes, fine-grained tokens do not.
+	if res.Scopes != nil {
+		info.Type = scm.TokenTypeOAuth
+	}
+	info.Expires = parseTokenExpiration(res.Header.Get("GitHub-Authentication-Token-Expiration"))
+	return info, res, nil
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("users/%s", login)
 	out := new(user)
@@ -112,3 +130,15 @@ func convertUser(from *user) *scm.User {
 		Updated: from.Updated,
 	}
 }
+
+// parseTokenExpiration parses the token expiration header, which
+// is formatted as either "2006-01-02 15:04:05 UTC" or
+// "2006-01-02 15:04:05 -0700".
+func parseTokenExpiration(value string) time.Time {
+	for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
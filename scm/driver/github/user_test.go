@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 
@@ -163,3 +164,56 @@ func TestUserAcceptInvitation(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestUserFindToken(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(200).
+		Type("application/json").
+		SetHeader("X-OAuth-Scopes", "repo, read:org").
+		SetHeader("GitHub-Authentication-Token-Expiration", "2021-07-21 11:27:36 UTC").
+		File("testdata/user.json")
+
+	client := NewDefault()
+	got, _, err := client.Users.FindToken(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := got.User.Login, "octocat"; got != want {
+		t.Errorf("Want user %s, got %s", want, got)
+	}
+	if diff := cmp.Diff(got.Scopes, []string{"repo", "read:org"}); diff != "" {
+		t.Errorf("Unexpected Scopes")
+		t.Log(diff)
+	}
+	if got, want := got.Expires, time.Date(2021, 7, 21, 11, 27, 36, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Want expiry %s, got %s", want, got)
+	}
+	if got, want := got.Type, scm.TokenTypeOAuth; got != want {
+		t.Errorf("Want token type %s, got %s", want, got)
+	}
+}
+
+func TestUserMissingScopes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/user").
+		Reply(403).
+		Type("application/json").
+		SetHeader("X-OAuth-Scopes", "repo").
+		SetHeader("X-Accepted-OAuth-Scopes", "user, user:email").
+		BodyString(`{"message":"Resource not accessible by integration"}`)
+
+	client := NewDefault()
+	_, _, err := client.Users.Find(context.Background())
+	want := scm.MissingScopes{Scopes: []string{"user", "user:email"}}
+	if diff := cmp.Diff(err, error(want)); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
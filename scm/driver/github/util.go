@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -43,6 +44,18 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Sha != "" {
 		params.Set("sha", opts.Sha)
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
 	return params.Encode()
 }
 
@@ -59,6 +72,32 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Closed {
 		params.Set("state", "closed")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("creator", opts.Author)
+	}
+	if opts.Mentioned != "" {
+		params.Set("mentioned", opts.Mentioned)
+	}
+	if opts.Milestone != 0 {
+		params.Set("milestone", strconv.Itoa(opts.Milestone))
+	}
+	if opts.UpdatedAfter != nil {
+		params.Set("since", opts.UpdatedAfter.UTC().Format(scm.SearchTimeFormat))
+	}
+	if opts.Sort != "" {
+		params.Set("sort", opts.Sort)
+		if opts.Ascending {
+			params.Set("direction", "asc")
+		} else {
+			params.Set("direction", "desc")
+		}
+	}
 	return params.Encode()
 }
 
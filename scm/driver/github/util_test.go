@@ -6,6 +6,7 @@ package github
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -35,6 +36,21 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Filters(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Ref:    "master",
+		Path:   "docs/README.md",
+		Author: "octocat",
+		Since:  time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	want := "author=octocat&path=docs%2FREADME.md&ref=master&since=2018-09-01T00%3A00%3A00Z&until=2018-10-01T00%3A00%3A00Z"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -63,6 +79,26 @@ func Test_encodeIssueListOptions_Closed(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	since := time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)
+	opts := scm.IssueListOptions{
+		Open:         true,
+		Labels:       []string{"kind/bug", "priority/high"},
+		Assignee:     "octocat",
+		Author:       "hubot",
+		Mentioned:    "monalisa",
+		Milestone:    3,
+		UpdatedAfter: &since,
+		Sort:         "updated",
+		Ascending:    true,
+	}
+	want := "assignee=octocat&creator=hubot&direction=asc&labels=kind%2Fbug%2Cpriority%2Fhigh&mentioned=monalisa&milestone=3&since=2018-09-01T00%3A00%3A00Z&sort=updated"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
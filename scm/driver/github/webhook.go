@@ -74,6 +74,8 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseInstallationRepositoryHook(data)
 	case "label":
 		hook, err = s.parseLabelHook(data)
+	case "merge_group":
+		hook, err = s.parseMergeGroupHook(data, guid)
 	case "ping":
 		hook, err = s.parsePingHook(data, guid)
 	case "push":
@@ -224,6 +226,17 @@ func (s *webhookService) parseCheckSuiteHook(data []byte) (scm.Webhook, error) {
 	return to, err
 }
 
+func (s *webhookService) parseMergeGroupHook(data []byte, guid string) (scm.Webhook, error) {
+	src := new(mergeGroupHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	to := convertMergeGroupHook(src)
+	to.GUID = guid
+	return to, err
+}
+
 func (s *webhookService) parseDeploymentStatusHook(data []byte) (scm.Webhook, error) {
 	src := new(deploymentStatusHook)
 	err := json.Unmarshal(data, src)
@@ -446,6 +459,22 @@ type (
 		Installation *installationRef `json:"installation"`
 	}
 
+	// github merge_group payload
+	mergeGroupHook struct {
+		Action     string `json:"action"`
+		Reason     string `json:"reason"`
+		MergeGroup struct {
+			HeadSha    string     `json:"head_sha"`
+			HeadRef    string     `json:"head_ref"`
+			BaseSha    string     `json:"base_sha"`
+			BaseRef    string     `json:"base_ref"`
+			HeadCommit pushCommit `json:"head_commit"`
+		} `json:"merge_group"`
+		Repository   repository       `json:"repository"`
+		Sender       user             `json:"sender"`
+		Installation *installationRef `json:"installation"`
+	}
+
 	// github deployment webhook payload
 	deploymentHook struct {
 		Deployment   deployment       `json:"deployment"`
@@ -848,6 +877,38 @@ func convertCheckSuiteHook(dst *checkSuiteHook) *scm.CheckSuiteHook {
 	}
 }
 
+func convertMergeGroupHook(src *mergeGroupHook) *scm.MergeGroupHook {
+	head := &src.MergeGroup.HeadCommit
+	timestamp, _ := time.Parse(time.RFC3339, head.Timestamp)
+	return &scm.MergeGroupHook{
+		Action: convertAction(src.Action),
+		MergeGroup: scm.MergeGroup{
+			HeadSha: src.MergeGroup.HeadSha,
+			HeadRef: src.MergeGroup.HeadRef,
+			BaseSha: src.MergeGroup.BaseSha,
+			BaseRef: src.MergeGroup.BaseRef,
+			HeadCommit: scm.Commit{
+				Sha:     head.ID,
+				Message: head.Message,
+				Author: scm.Signature{
+					Name:  head.Author.Name,
+					Email: head.Author.Email,
+					Date:  timestamp,
+				},
+				Committer: scm.Signature{
+					Name:  head.Committer.Name,
+					Email: head.Committer.Email,
+					Date:  timestamp,
+				},
+			},
+		},
+		Reason:       src.Reason,
+		Repo:         *convertRepository(&src.Repository),
+		Sender:       *convertUser(&src.Sender),
+		Installation: convertInstallationRef(src.Installation),
+	}
+}
+
 func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	dst := &scm.DeployHook{
 		Deployment: *convertDeployment(&src.Deployment, src.Repository.FullName),
@@ -1183,6 +1244,10 @@ func convertAction(src string) (action scm.Action) {
 		return scm.ActionSync
 	case "complete", "completed":
 		return scm.ActionCompleted
+	case "checks_requested":
+		return scm.ActionChecksRequested
+	case "destroyed":
+		return scm.ActionDestroyed
 	default:
 		return
 	}
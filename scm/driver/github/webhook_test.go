@@ -103,6 +103,15 @@ func TestWebhooks(t *testing.T) {
 			obj:    new(scm.LabelHook),
 		},
 
+		// merge_group
+		{
+			name:   "merge_group",
+			event:  "merge_group",
+			before: "testdata/webhooks/merge_group.json",
+			after:  "testdata/webhooks/merge_group.json.golden",
+			obj:    new(scm.MergeGroupHook),
+		},
+
 		// ping
 		{
 			name:   "ping",
@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+func (s *approvalService) ListRules(ctx context.Context, repo string, number int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approval_state", encode(repo), number)
+	out := new(approvalState)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertApprovalRuleList(out.Rules), res, err
+}
+
+// FindStatus returns the approval rules as evaluated by GitLab,
+// including the approvals that do not count towards any rule.
+func (s *approvalService) FindStatus(ctx context.Context, repo string, number int) (*scm.ApprovalStatus, *scm.Response, error) {
+	rules, res, err := s.ListRules(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approvals", encode(repo), number)
+	out := new(approvals)
+	res, err = s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	status := &scm.ApprovalStatus{
+		Approved:   out.Approved,
+		ApprovedBy: []scm.User{},
+		Rules:      rules,
+	}
+	for _, v := range out.ApprovedBy {
+		status.ApprovedBy = append(status.ApprovedBy, *convertUser(&v.User))
+	}
+	for _, rule := range rules {
+		if !rule.Approved {
+			status.Missing = append(status.Missing, rule)
+		}
+	}
+	return status, res, nil
+}
+
+func (s *approvalService) Approve(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/approve", encode(repo), number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *approvalService) Unapprove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/unapprove", encode(repo), number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+type approvalState struct {
+	Rules []*approvalRule `json:"rules"`
+}
+
+type approvalRule struct {
+	ID                int     `json:"id"`
+	Name              string  `json:"name"`
+	ApprovalsRequired int     `json:"approvals_required"`
+	EligibleApprovers []*user `json:"eligible_approvers"`
+	ApprovedBy        []*user `json:"approved_by"`
+	Approved          bool    `json:"approved"`
+}
+
+type approvals struct {
+	Approved   bool `json:"approved"`
+	ApprovedBy []struct {
+		User user `json:"user"`
+	} `json:"approved_by"`
+}
+
+func convertApprovalRuleList(from []*approvalRule) []*scm.ApprovalRule {
+	to := []*scm.ApprovalRule{}
+	for _, v := range from {
+		to = append(to, convertApprovalRule(v))
+	}
+	return to
+}
+
+func convertApprovalRule(from *approvalRule) *scm.ApprovalRule {
+	return &scm.ApprovalRule{
+		ID:         from.ID,
+		Name:       from.Name,
+		Required:   from.ApprovalsRequired,
+		Approvers:  convertUserList(from.EligibleApprovers),
+		ApprovedBy: convertUserList(from.ApprovedBy),
+		Approved:   from.Approved,
+	}
+}
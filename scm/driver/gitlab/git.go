@@ -6,8 +6,11 @@ package gitlab
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -63,6 +66,26 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return scmRef, res, err
 }
 
+// CreateTag creates an annotated tag. A tag without a message would
+// be created as a lightweight tag, so the message is required.
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	if input.Message == "" {
+		return nil, nil, scm.ErrTagMessageRequired
+	}
+	if input.Tagger != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags", encode(repo))
+	in := &tagInput{
+		TagName: input.Name,
+		Ref:     input.Target,
+		Message: input.Message,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAnnotatedTag(out), res, err
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -74,11 +97,75 @@ func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Re
 	return convertBranch(out), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	params := url.Values{
+		"branch": []string{input.Name},
+		"ref":    []string{input.Ref},
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/branches?%s", encode(repo), params.Encode())
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, nil, out)
+	return convertBranch(out), res, err
+}
+
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s", encode(repo), encode(scm.TrimRef(ref)))
 	out := new(commit)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
-	return convertCommit(out), res, err
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertCommit(out)
+
+	// the changed files and the signature are not included
+	// in the commit and require separate requests, which are
+	// best-effort: a failure leaves the optional fields unset
+	// rather than failing to find the commit.
+	if files, err := s.listAllChanges(ctx, repo, out.ID); err == nil {
+		to.Files = files
+	}
+	if verification, err := s.findSignature(ctx, repo, out); err == nil {
+		to.Verification = verification
+	}
+	return to, res, nil
+}
+
+// listAllChanges returns the changed files of the commit, following
+// the pagination of the diff.
+func (s *gitService) listAllChanges(ctx context.Context, repo, sha string) ([]*scm.Change, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	var changes []*scm.Change
+	for {
+		out, res, err := s.ListChanges(ctx, repo, sha, opts)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, out...)
+		if res.Page.Next == 0 {
+			return changes, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// findSignature returns the signature verification of the commit.
+//
+// See https://docs.gitlab.com/ee/api/commits.html#get-signature-of-a-commit
+func (s *gitService) findSignature(ctx context.Context, repo string, from *commit) (*scm.Verification, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/signature", encode(repo), from.ID)
+	out := new(signature)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == http.StatusNotFound {
+		return &scm.Verification{Reason: "unsigned"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertSignature(out, from), nil
 }
 
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
@@ -88,6 +175,13 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return convertTag(out), res, err
 }
 
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/tags/%s", encode(repo), encode(name))
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertAnnotatedTag(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/repository/branches?%s", encode(repo), encodeListOptions(opts))
 	out := []*branch{}
@@ -96,7 +190,7 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/repository/commits?%s", encode(repo), encodeCommitListOptions(opts))
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits?with_stats=true&%s", encode(repo), encodeCommitListOptions(opts))
 	out := []*commit{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertCommitList(out), res, err
@@ -110,7 +204,7 @@ func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOpt
 }
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/diff", encode(repo), encode(ref))
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits/%s/diff?%s", encode(repo), encode(ref), encodeListOptions(opts))
 	out := []*change{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertChangeList(out), res, err
@@ -125,17 +219,180 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return convertChangeList(out.Diffs), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/repository/blobs/%s", encode(repo), sha)
+	out := new(blob)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	content, err := base64.StdEncoding.DecodeString(out.Content)
+	return &scm.Blob{Sha: out.Sha, Size: out.Size, Content: content}, res, err
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// FindTree lists the tree of the given commit sha or ref. The tree
+// sha is not reported by the api, so the given sha is returned.
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	tree := &scm.Tree{Sha: sha}
+	params := url.Values{}
+	params.Set("ref", sha)
+	params.Set("per_page", "100")
+	if recursive {
+		params.Set("recursive", "true")
+	}
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("api/v4/projects/%s/repository/tree?%s", encode(repo), params.Encode())
+		out := []*treeEntry{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			tree.Entries = append(tree.Entries, &scm.TreeEntry{
+				Path: v.Path,
+				Mode: v.Mode,
+				Type: v.Type,
+				Sha:  v.ID,
+			})
+		}
+		if res.Page.Next == 0 {
+			return tree, res, nil
+		}
+	}
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CommitFiles atomically commits the changes of many files with the
+// commit actions api.
+//
+// See https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	in := &commitActionsInput{
+		Branch:        input.Branch,
+		CommitMessage: input.Message,
+	}
+	if input.Author != nil {
+		in.AuthorName = input.Author.Name
+		in.AuthorEmail = input.Author.Email
+	}
+	if input.BaseBranch != "" {
+		// the start branch is only used to create a missing branch.
+		_, res, err := s.FindBranch(ctx, repo, input.Branch)
+		if err != nil && (res == nil || res.Status != 404) {
+			return nil, res, err
+		}
+		if err != nil {
+			in.StartBranch = input.BaseBranch
+		}
+	}
+	for _, file := range input.Files {
+		action := &commitAction{
+			Action:       string(file.Action),
+			FilePath:     file.Path,
+			PreviousPath: file.PreviousPath,
+		}
+		if file.Action != scm.FileActionDelete && (file.Action != scm.FileActionMove || file.Content != nil) {
+			action.Content = base64.StdEncoding.EncodeToString(file.Content)
+			action.Encoding = "base64"
+		}
+		in.Actions = append(in.Actions, action)
+		// the file mode is only set by a separate chmod action.
+		if file.Executable && file.Action != scm.FileActionDelete {
+			executable := true
+			in.Actions = append(in.Actions, &commitAction{
+				Action:          "chmod",
+				FilePath:        file.Path,
+				ExecuteFilemode: &executable,
+			})
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repo))
+	out := new(commit)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertCommit(out), res, err
+}
+
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+type blob struct {
+	Sha      string `json:"sha"`
+	Size     int64  `json:"size"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+type treeEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type commitActionsInput struct {
+	Branch        string          `json:"branch"`
+	CommitMessage string          `json:"commit_message"`
+	StartBranch   string          `json:"start_branch,omitempty"`
+	Actions       []*commitAction `json:"actions"`
+	AuthorEmail   string          `json:"author_email,omitempty"`
+	AuthorName    string          `json:"author_name,omitempty"`
+}
+
+type commitAction struct {
+	Action          string `json:"action"`
+	FilePath        string `json:"file_path"`
+	PreviousPath    string `json:"previous_path,omitempty"`
+	Content         string `json:"content,omitempty"`
+	Encoding        string `json:"encoding,omitempty"`
+	ExecuteFilemode *bool  `json:"execute_filemode,omitempty"`
+}
+
 type compare struct {
 	Diffs []*change `json:"diffs"`
 }
 
 type branch struct {
-	Name   string `json:"name"`
-	Commit struct {
-		ID string `json:"id"`
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+	Commit    struct {
+		ID            string    `json:"id"`
+		CommittedDate time.Time `json:"committed_date"`
 	}
 }
 
+type tag struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+	Target  string `json:"target"`
+	Commit  struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+type tagInput struct {
+	TagName string `json:"tag_name"`
+	Ref     string `json:"ref"`
+	Message string `json:"message,omitempty"`
+}
+
 type commit struct {
 	ID             string    `json:"id"`
 	Title          string    `json:"title"`
@@ -148,6 +405,21 @@ type commit struct {
 	CommitterEmail string    `json:"committer_email"`
 	Created        time.Time `json:"created_at"`
 	URL            string    `json:"web_url"`
+	ParentIDs      []string  `json:"parent_ids"`
+	Stats          *struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+		Total     int `json:"total"`
+	} `json:"stats"`
+}
+
+type signature struct {
+	Type               string `json:"signature_type"`
+	VerificationStatus string `json:"verification_status"`
+	GPGKeyUserEmail    string `json:"gpg_key_user_email"`
+	X509Certificate    *struct {
+		Email string `json:"email"`
+	} `json:"x509_certificate"`
 }
 
 func convertCommitList(from []*commit) []*scm.Commit {
@@ -159,7 +431,7 @@ func convertCommitList(from []*commit) []*scm.Commit {
 }
 
 func convertCommit(from *commit) *scm.Commit {
-	return &scm.Commit{
+	to := &scm.Commit{
 		Message: from.Message,
 		Sha:     from.ID,
 		Link:    from.URL,
@@ -175,7 +447,34 @@ func convertCommit(from *commit) *scm.Commit {
 			Email: from.CommitterEmail,
 			Date:  from.CommittedDate,
 		},
+		Parents: from.ParentIDs,
 	}
+	if from.Stats != nil {
+		to.Stats = &scm.CommitStats{
+			Additions: from.Stats.Additions,
+			Deletions: from.Stats.Deletions,
+			Total:     from.Stats.Total,
+		}
+	}
+	return to
+}
+
+func convertSignature(from *signature, signed *commit) *scm.Verification {
+	to := &scm.Verification{
+		Verified: from.VerificationStatus == "verified",
+		Reason:   from.VerificationStatus,
+	}
+	switch {
+	case from.GPGKeyUserEmail != "":
+		to.Signer = from.GPGKeyUserEmail
+	case from.X509Certificate != nil:
+		to.Signer = from.X509Certificate.Email
+	case to.Verified:
+		// ssh signatures are only verified when the key
+		// belongs to the committer.
+		to.Signer = signed.CommitterEmail
+	}
+	return to
 }
 
 func convertBranchList(from []*branch) []*scm.Reference {
@@ -188,9 +487,11 @@ func convertBranchList(from []*branch) []*scm.Reference {
 
 func convertBranch(from *branch) *scm.Reference {
 	return &scm.Reference{
-		Name: scm.TrimRef(from.Name),
-		Path: scm.ExpandRef(from.Name, "refs/heads/"),
-		Sha:  from.Commit.ID,
+		Name:      scm.TrimRef(from.Name),
+		Path:      scm.ExpandRef(from.Name, "refs/heads/"),
+		Sha:       from.Commit.ID,
+		Protected: from.Protected,
+		Date:      from.Commit.CommittedDate,
 	}
 }
 
@@ -202,6 +503,20 @@ func convertTagList(from []*branch) []*scm.Reference {
 	return to
 }
 
+func convertAnnotatedTag(from *tag) *scm.Tag {
+	to := &scm.Tag{
+		Name:       from.Name,
+		Message:    from.Message,
+		Target:     from.Commit.ID,
+		TargetType: "commit",
+	}
+	// the target of a lightweight tag is the commit itself.
+	if from.Target != from.Commit.ID {
+		to.Sha = from.Target
+	}
+	return to
+}
+
 func convertTag(from *branch) *scm.Reference {
 	return &scm.Reference{
 		Name: scm.TrimRef(from.Name),
@@ -31,6 +31,18 @@ func TestGitFindCommit(t *testing.T) {
 		SetHeaders(mockHeaders).
 		File("testdata/commit.json")
 
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_diff.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_signature.json")
+
 	client := NewDefault()
 	got, res, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
 	if err != nil {
@@ -51,6 +63,118 @@ func TestGitFindCommit(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitFindCommitUnsigned(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_diff.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"404 Signature Not Found"}`)
+
+	client := NewDefault()
+	got, _, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Verification{Reason: "unsigned"}
+	if diff := cmp.Diff(got.Verification, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindCommitDetailsUnavailable(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		Reply(500)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(500)
+
+	client := NewDefault()
+	got, _, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Sha != "6104942438c14ec7bd21c6cd5bd995272b3faff6" {
+		t.Errorf("Unexpected commit sha %s", got.Sha)
+	}
+	if got.Files != nil || got.Verification != nil {
+		t.Errorf("Want no files and verification, got %v and %v", got.Files, got.Verification)
+	}
+}
+
+func TestGitFindCommitPaginatedFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/7fd1a60b01f91b314f59955a4e4d4e80d8edf11d").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockPageHeaders).
+		File("testdata/commit_diff.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/diff").
+		MatchParam("page", "2").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_diff.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/commits/6104942438c14ec7bd21c6cd5bd995272b3faff6/signature").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"message":"404 Signature Not Found"}`)
+
+	client := NewDefault()
+	got, _, err := client.Git.FindCommit(context.Background(), "diaspora/diaspora", "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := len(got.Files), 2; got != want {
+		t.Errorf("Want %d changed files, got %d", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -111,6 +235,88 @@ func TestGitFindTag(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/tags").
+		JSON(map[string]string{
+			"tag_name": "v1.0.0",
+			"ref":      "2695effb5807a22ff3d138d593fd856244e155e7",
+			"message":  "Release v1.0.0",
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_create.json")
+
+	client := NewDefault()
+	input := &scm.TagInput{
+		Name:    "v1.0.0",
+		Message: "Release v1.0.0",
+		Target:  "2695effb5807a22ff3d138d593fd856244e155e7",
+	}
+	got, res, err := client.Git.CreateTag(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitCreateTagWithoutMessage(t *testing.T) {
+	client := NewDefault()
+	input := &scm.TagInput{
+		Name:   "v1.0.0",
+		Target: "2695effb5807a22ff3d138d593fd856244e155e7",
+	}
+	_, _, err := client.Git.CreateTag(context.Background(), "diaspora/diaspora", input)
+	if err != scm.ErrTagMessageRequired {
+		t.Errorf("Want ErrTagMessageRequired, got %v", err)
+	}
+}
+
+func TestGitFindTagObject(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/tag_create.json")
+
+	client := NewDefault()
+	got, res, err := client.Git.FindTagObject(context.Background(), "diaspora/diaspora", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestGitListCommits(t *testing.T) {
 	defer gock.Off()
 
@@ -119,6 +325,7 @@ func TestGitListCommits(t *testing.T) {
 		MatchParam("page", "1").
 		MatchParam("per_page", "30").
 		MatchParam("ref_name", "master").
+		MatchParam("with_stats", "true").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
@@ -306,3 +513,144 @@ func TestGitCreateRef(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/branches").
+		MatchParam("branch", "newbranch").
+		MatchParam("ref", "master").
+		Reply(http.StatusCreated).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/create_branch.json")
+
+	client := NewDefault()
+	input := &scm.BranchInput{Name: "newbranch", Ref: "master"}
+	got, res, err := client.Git.CreateBranch(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestGitRenameBranch(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Git.RenameBranch(context.Background(), "diaspora/diaspora", "master", "newbranch")
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestGitFindTree(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("ref", "master").
+		MatchParam("recursive", "true").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/tree.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/tree").
+		MatchParam("page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString("[]")
+
+	client := NewDefault()
+	got, _, err := client.Git.FindTree(context.Background(), "diaspora/diaspora", "master", true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tree)
+	raw, _ := ioutil.ReadFile("testdata/tree.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestGitCommitFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/repository/branches/feature").
+		Reply(404)
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/repository/commits").
+		JSON(map[string]interface{}{
+			"branch":         "feature",
+			"start_branch":   "master",
+			"commit_message": "Sanitize for network graph",
+			"author_name":    "randx",
+			"author_email":   "dmitriy.zaporozhets@gmail.com",
+			"actions": []map[string]interface{}{
+				{"action": "create", "file_path": "bin/run", "content": "IyEvYmluL3NoCg==", "encoding": "base64"},
+				{"action": "chmod", "file_path": "bin/run", "execute_filemode": true},
+				{"action": "move", "file_path": "doc/README.md", "previous_path": "README.md"},
+				{"action": "delete", "file_path": "CHANGELOG"},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/commit.json")
+
+	client := NewDefault()
+	input := &scm.CommitFilesInput{
+		Branch:     "feature",
+		BaseBranch: "master",
+		Message:    "Sanitize for network graph",
+		Author:     &scm.Signature{Name: "randx", Email: "dmitriy.zaporozhets@gmail.com"},
+		Files: []*scm.FileChange{
+			{Action: scm.FileActionCreate, Path: "bin/run", Content: []byte("#!/bin/sh\n"), Executable: true},
+			{Action: scm.FileActionMove, Path: "doc/README.md", PreviousPath: "README.md"},
+			{Action: scm.FileActionDelete, Path: "CHANGELOG"},
+		},
+	}
+	got, res, err := client.Git.CommitFiles(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Commit)
+	raw, _ := ioutil.ReadFile("testdata/commit_files.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -42,12 +42,17 @@ func New(uri string) (*scm.Client, error) {
 	client.Contents = &contentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.MergeQueues = &mergeQueueService{client}
 	client.Releases = &releaseService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Approvals = &approvalService{client}
+	client.Reactions = &reactionService{client}
+	client.Search = &searchService{client}
+	client.Server = &serverService{client}
 	client.Commits = &commitService{client}
 
 	//add the user service to the webhook service so it can be used for fetching users
@@ -127,6 +132,14 @@ func (c *wrapper) findNamespaceByName(ctx context.Context, name string) (*gitlab
 	return nil, err
 }
 
+// serverAtLeast returns true if the server is known to run at least
+// the given version. Drivers fall back to the oldest supported API
+// when the version cannot be determined.
+func (c *wrapper) serverAtLeast(ctx context.Context, version string) bool {
+	info, err := c.ServerInfo(ctx)
+	return err == nil && info.AtLeast(version)
+}
+
 // do wraps the Client.Do function by creating the Request and
 // unmarshalling the response.
 func (c *wrapper) do(ctx context.Context, method, path string, in, out interface{}) (*scm.Response, error) {
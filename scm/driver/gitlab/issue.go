@@ -6,8 +6,10 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,7 +27,7 @@ func (s *issueService) Search(context.Context, scm.SearchOptions) ([]*scm.Search
 }
 
 func (s *issueService) AssignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
-	issue, _, err := s.Find(ctx, repo, number)
+	issue, _, err := s.find(ctx, repo, number)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +62,7 @@ func (s *issueService) setAssignees(ctx context.Context, repo string, number int
 }
 
 func (s *issueService) UnassignIssue(ctx context.Context, repo string, number int, logins []string) (*scm.Response, error) {
-	issue, _, err := s.Find(ctx, repo, number)
+	issue, _, err := s.find(ctx, repo, number)
 	if err != nil {
 		return nil, err
 	}
@@ -88,16 +90,11 @@ func (s *issueService) ListEvents(ctx context.Context, repo string, index int, o
 }
 
 func (s *issueService) ListLabels(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
-	issue, issueResp, err := s.Find(ctx, repo, number)
+	issue, issueResp, err := s.find(ctx, repo, number)
 	if err != nil {
 		return nil, issueResp, err
 	}
-	var labels []*scm.Label
-	for _, l := range issue.Labels {
-		labels = append(labels, &scm.Label{
-			Name: l,
-		})
-	}
+	labels := issue.Labels
 	return labels, issueResp, err
 }
 
@@ -145,12 +142,40 @@ func (s *issueService) DeleteLabel(ctx context.Context, repo string, number int,
 }
 
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/issues/%d", encode(repo), number)
+	to, res, err := s.find(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	to.LinkedPullRequests, res, err = s.listClosedBy(ctx, repo, number)
+	return to, res, err
+}
+
+// find returns the issue without the linked merge requests.
+func (s *issueService) find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d?with_labels_details=true", encode(repo), number)
 	out := new(issue)
 	res, err := s.client.do(ctx, "GET", path, nil, out)
 	return convertIssue(out), res, err
 }
 
+// listClosedBy returns the numbers of the merge requests closing
+// the issue when merged.
+func (s *issueService) listClosedBy(ctx context.Context, repo string, number int) ([]int, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/closed_by", encode(repo), number)
+	out := []*struct {
+		Number int `json:"iid"`
+	}{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	numbers := []int{}
+	for _, v := range out {
+		numbers = append(numbers, v.Number)
+	}
+	return numbers, res, nil
+}
+
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d", encode(repo), index, id)
 	out := new(issueComment)
@@ -159,7 +184,19 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), encodeIssueListOptions(opts))
+	// the api cannot filter issues by mentioned user.
+	if opts.Mentioned != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/issues?with_labels_details=true&%s", encode(repo), encodeIssueListOptions(opts))
+	// gitlab filters issues by the milestone title.
+	if opts.Milestone != 0 {
+		m, res, err := s.client.Milestones.Find(ctx, repo, opts.Milestone)
+		if err != nil {
+			return nil, res, err
+		}
+		path += "&" + url.Values{"milestone": {m.Title}}.Encode()
+	}
 	out := []*issue{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertIssueList(out), res, err
@@ -176,6 +213,19 @@ func (s *issueService) Create(ctx context.Context, repo string, input *scm.Issue
 	in := url.Values{}
 	in.Set("title", input.Title)
 	in.Set("description", input.Body)
+	if len(input.Labels) != 0 {
+		in.Set("labels", strings.Join(input.Labels, ","))
+	}
+	for _, login := range input.Assignees {
+		user, res, err := s.client.Users.FindLogin(ctx, login)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Add("assignee_ids[]", strconv.Itoa(user.ID))
+	}
+	if input.Milestone != 0 {
+		in.Set("milestone_id", strconv.Itoa(input.Milestone))
+	}
 	path := fmt.Sprintf("api/v4/projects/%s/issues?%s", encode(repo), in.Encode())
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, nil, out)
@@ -261,14 +311,14 @@ type updateIssueOptions struct {
 }
 
 type issue struct {
-	ID     int      `json:"id"`
-	Number int      `json:"iid"`
-	State  string   `json:"state"`
-	Title  string   `json:"title"`
-	Desc   string   `json:"description"`
-	Link   string   `json:"web_url"`
-	Locked bool     `json:"discussion_locked"`
-	Labels []string `json:"labels"`
+	ID     int         `json:"id"`
+	Number int         `json:"iid"`
+	State  string      `json:"state"`
+	Title  string      `json:"title"`
+	Desc   string      `json:"description"`
+	Link   string      `json:"web_url"`
+	Locked bool        `json:"discussion_locked"`
+	Labels issueLabels `json:"labels"`
 	Author struct {
 		Name     string      `json:"name"`
 		Username string      `json:"username"`
@@ -276,8 +326,41 @@ type issue struct {
 	} `json:"author"`
 	Assignee  *issueAssignee   `json:"assignee"`
 	Assignees []*issueAssignee `json:"assignees"`
+	ClosedBy  *issueAssignee   `json:"closed_by"`
+	Milestone *milestone       `json:"milestone"`
+	IssueType string           `json:"issue_type"`
+	Weight    null.Int         `json:"weight"`
+	DueDate   *isoTime         `json:"due_date"`
 	Created   time.Time        `json:"created_at"`
 	Updated   time.Time        `json:"updated_at"`
+	Closed    *time.Time       `json:"closed_at"`
+}
+
+// issueLabels are the labels of an issue, which are names unless
+// the label details are requested.
+type issueLabels []*scm.Label
+
+// UnmarshalJSON unmarshals the label names or details.
+func (l *issueLabels) UnmarshalJSON(data []byte) error {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	labels := issueLabels{}
+	for _, v := range raw {
+		var name string
+		if err := json.Unmarshal(v, &name); err == nil {
+			labels = append(labels, &scm.Label{Name: name})
+			continue
+		}
+		out := new(label)
+		if err := json.Unmarshal(v, out); err != nil {
+			return err
+		}
+		labels = append(labels, convertLabel(out))
+	}
+	*l = labels
+	return nil
 }
 
 type issueAssignee struct {
@@ -315,7 +398,7 @@ func convertIssueList(from []*issue) []*scm.Issue {
 // helper function to convert from the gitlab issue structure to
 // the common issue structure.
 func convertIssue(from *issue) *scm.Issue {
-	return &scm.Issue{
+	to := &scm.Issue{
 		Number: from.Number,
 		Title:  from.Title,
 		Body:   from.Desc,
@@ -330,9 +413,22 @@ func convertIssue(from *issue) *scm.Issue {
 			Avatar: from.Author.Avatar.String,
 		},
 		Assignees: convertIssueAssignees(from.Assignee, from.Assignees),
+		Milestone: convertMilestone(from.Milestone),
+		Type:      from.IssueType,
+		Weight:    int(from.Weight.Int64),
 		Created:   from.Created,
 		Updated:   from.Updated,
+		ClosedAt:  from.Closed,
 	}
+	if from.ClosedBy != nil {
+		closedBy := convertSingleIssueAssignee(from.ClosedBy)
+		to.ClosedBy = &closedBy
+	}
+	if from.DueDate != nil {
+		dueDate := time.Time(*from.DueDate)
+		to.DueDate = &dueDate
+	}
+	return to
 }
 
 // helper function to convert from the gitlab issue assignee(s) to the common user structure.
@@ -21,11 +21,19 @@ func TestIssueFind(t *testing.T) {
 
 	gock.New("https://gitlab.com").
 		Get("/api/v4/projects/diaspora/diaspora/issues/1").
+		MatchParam("with_labels_details", "true").
 		Reply(200).
 		Type("application/json").
 		SetHeaders(mockHeaders).
 		File("testdata/issue.json")
 
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues/1/closed_by").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue_closed_by.json")
+
 	client := NewDefault()
 	got, res, err := client.Issues.Find(context.Background(), "diaspora/diaspora", 1)
 	if err != nil {
@@ -36,6 +44,7 @@ func TestIssueFind(t *testing.T) {
 	want := new(scm.Issue)
 	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
 	json.Unmarshal(raw, want)
+	want.LinkedPullRequests = []int{15}
 
 	if diff := cmp.Diff(got, want); diff != "" {
 		t.Errorf("Unexpected Results")
@@ -111,6 +120,53 @@ func TestIssueList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestIssueListFilters(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/milestones/12").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/milestone.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/issues").
+		MatchParam("labels", "kind/bug").
+		MatchParam("assignee_username", "lennie").
+		MatchParam("milestone", "10.0").
+		MatchParam("state", "opened").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issues.json")
+
+	opts := scm.IssueListOptions{
+		Open:      true,
+		Labels:    []string{"kind/bug"},
+		Assignee:  "lennie",
+		Milestone: 12,
+	}
+
+	client := NewDefault()
+	got, _, err := client.Issues.List(context.Background(), "diaspora/diaspora", opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 1 {
+		t.Errorf("Want 1 issue, got %d", len(got))
+	}
+}
+
+func TestIssueListMentioned(t *testing.T) {
+	client := NewDefault()
+	_, _, err := client.Issues.List(context.Background(), "diaspora/diaspora", scm.IssueListOptions{Mentioned: "lennie"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
 func TestIssueListComments(t *testing.T) {
 	defer gock.Off()
 
@@ -217,6 +273,53 @@ func TestIssueCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestIssueCreateWithLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/issues").
+		MatchParam("labels", "bug,ui").
+		MatchParam("assignee_ids[]", "1").
+		MatchParam("milestone_id", "11").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Found a bug",
+		Body:      "I'm having a problem with this.",
+		Labels:    []string{"bug", "ui"},
+		Assignees: []string{"john_smith"},
+		Milestone: 11,
+	}
+
+	client := NewDefault()
+	got, res, err := client.Issues.Create(context.Background(), "diaspora/diaspora", &input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if len(got.Labels) != 1 || got.Labels[0].Name != "bug" {
+		t.Errorf("Unexpected labels %v", got.Labels)
+	}
+	if got.Milestone == nil || got.Milestone.Title != "v3.0" {
+		t.Errorf("Unexpected milestone %v", got.Milestone)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestIssueCreateComment(t *testing.T) {
 	defer gock.Off()
 
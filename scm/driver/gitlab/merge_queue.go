@@ -0,0 +1,109 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// mergeQueueService provides access to the merge trains of a
+// project.
+type mergeQueueService struct {
+	client *wrapper
+}
+
+func (s *mergeQueueService) List(ctx context.Context, repo, branch string, opts scm.ListOptions) ([]*scm.MergeQueueEntry, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_trains/%s?%s", encode(repo), encode(branch), encodeMergeTrainListOptions(opts))
+	out := []*mergeTrain{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	// the position of the entries is their index in the train,
+	// offset by the previous pages.
+	offset := 0
+	if opts.Page > 1 {
+		size := opts.Size
+		if size == 0 {
+			size = 20
+		}
+		offset = (opts.Page - 1) * size
+	}
+	return convertMergeTrainList(out, offset), res, err
+}
+
+// Add adds the merge request to the merge train of its target
+// branch. The api returns the train, which is searched for the
+// merge request.
+func (s *mergeQueueService) Add(ctx context.Context, repo string, number int, opts *scm.MergeQueueOptions) (*scm.MergeQueueEntry, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_trains/merge_requests/%d", encode(repo), number)
+	in := new(mergeTrainInput)
+	if opts != nil {
+		in.Sha = opts.SHA
+	}
+	out := []*mergeTrain{}
+	res, err := s.client.do(ctx, "POST", path, in, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, entry := range convertMergeTrainList(out, 0) {
+		if entry.Number == number {
+			return entry, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// Remove removes the merge request from the merge train, which is
+// done by cancelling its auto-merge.
+func (s *mergeQueueService) Remove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/cancel_merge_when_pipeline_succeeds", encode(repo), number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+type mergeTrain struct {
+	ID           int `json:"id"`
+	MergeRequest struct {
+		IID int `json:"iid"`
+	} `json:"merge_request"`
+	User     user `json:"user"`
+	Pipeline *struct {
+		Sha string `json:"sha"`
+	} `json:"pipeline"`
+	TargetBranch string    `json:"target_branch"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type mergeTrainInput struct {
+	Sha string `json:"sha,omitempty"`
+}
+
+func convertMergeTrainList(from []*mergeTrain, offset int) []*scm.MergeQueueEntry {
+	to := []*scm.MergeQueueEntry{}
+	for i, v := range from {
+		entry := convertMergeTrain(v)
+		entry.Position = offset + i + 1
+		to = append(to, entry)
+	}
+	return to
+}
+
+func convertMergeTrain(from *mergeTrain) *scm.MergeQueueEntry {
+	to := &scm.MergeQueueEntry{
+		ID:      strconv.Itoa(from.ID),
+		Number:  from.MergeRequest.IID,
+		State:   from.Status,
+		BaseRef: from.TargetBranch,
+		Author:  *convertUser(&from.User),
+		Created: from.CreatedAt,
+	}
+	if from.Pipeline != nil {
+		to.Sha = from.Pipeline.Sha
+	}
+	return to
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestMergeQueueList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_trains/master").
+		MatchParam("scope", "active").
+		MatchParam("sort", "asc").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merge_trains.json")
+
+	client := NewDefault()
+	got, res, err := client.MergeQueues.List(context.Background(), "diaspora/diaspora", "master", scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.MergeQueueEntry{}
+	raw, _ := ioutil.ReadFile("testdata/merge_trains.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
+func TestMergeQueueListPosition(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_trains/master").
+		MatchParam("page", "3").
+		MatchParam("per_page", "2").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_trains.json")
+
+	client := NewDefault()
+	got, _, err := client.MergeQueues.List(context.Background(), "diaspora/diaspora", "master", scm.ListOptions{Page: 3, Size: 2})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got[0].Position != 5 || got[1].Position != 6 {
+		t.Errorf("Want positions 5 and 6, got %d and %d", got[0].Position, got[1].Position)
+	}
+}
+
+func TestMergeQueueAdd(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_trains/merge_requests/1348").
+		JSON(map[string]string{"sha": "6104942438c14ec7bd21c6cd5bd995272b3faff6"}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_trains.json")
+
+	client := NewDefault()
+	opts := &scm.MergeQueueOptions{SHA: "6104942438c14ec7bd21c6cd5bd995272b3faff6"}
+	got, res, err := client.MergeQueues.Add(context.Background(), "diaspora/diaspora", 1348, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.MergeQueueEntry{}
+	raw, _ := ioutil.ReadFile("testdata/merge_trains.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[1]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestMergeQueueRemove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/cancel_merge_when_pipeline_succeeds").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.MergeQueues.Remove(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/copystructure"
@@ -16,6 +18,10 @@ import (
 	"github.com/jenkins-x/go-scm/scm"
 )
 
+// draftRe matches the title prefixes marking a merge request as a
+// draft.
+var draftRe = regexp.MustCompile(`(?i)^\s*(\[draft\]|\(draft\)|draft:|draft\s+-)\s*`)
+
 type pullService struct {
 	client *wrapper
 }
@@ -55,7 +61,22 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convRepos, res, nil
 }
 
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/commits?%s", encode(repo), number, encodeListOptions(opts))
+	out := []*commit{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
+	// the paginated diffs endpoint replaces the deprecated changes
+	// endpoint from GitLab 15.7 onwards.
+	if s.client.serverAtLeast(ctx, "15.7") {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/diffs?%s", encode(repo), number, encodeListOptions(opts))
+		out := []*change{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		return convertChangeList(out), res, err
+	}
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/changes?%s", encode(repo), number, encodeListOptions(opts))
 	out := new(changes)
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
@@ -130,6 +151,96 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int, option
 	return res, err
 }
 
+// EnableAutoMerge merges the merge request when the pipeline
+// succeeds. The merge request cannot be rebased automatically.
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, opts *scm.AutoMergeOptions) (*scm.Response, error) {
+	in := &scm.PullRequestMergeOptions{MergeWhenPipelineSucceeds: true}
+	if opts != nil {
+		if opts.MergeMethod == "rebase" {
+			return nil, scm.ErrNotSupported
+		}
+		in.MergeMethod = opts.MergeMethod
+		in.SHA = opts.SHA
+		in.CommitTitle = opts.CommitTitle
+		if opts.CommitMessage != "" {
+			in.CommitTitle = strings.TrimSpace(opts.CommitTitle + "\n\n" + opts.CommitMessage)
+		}
+	}
+	return s.Merge(ctx, repo, number, in)
+}
+
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/cancel_merge_when_pipeline_succeeds", encode(repo), number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// SetDraft adds, or removes, the Draft prefix of the merge request
+// title, which is how GitLab marks drafts.
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(pr)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	title := draftTitle(out.Title, draft)
+	if title == out.Title {
+		return res, nil
+	}
+	return s.client.do(ctx, "PUT", path, &updateMergeRequestOptions{Title: &title}, nil)
+}
+
+// draftTitle returns the title with, or without, the Draft prefix.
+func draftTitle(title string, draft bool) string {
+	switch {
+	case draft && draftRe.MatchString(title):
+		return title
+	case draft:
+		return "Draft: " + title
+	default:
+		return draftRe.ReplaceAllString(title, "")
+	}
+}
+
+// UpdatePullRequestBranch rebases the merge request branch. The
+// api cannot merge the target branch into the source branch.
+func (s *pullService) UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	if opts == nil || opts.Method != "rebase" {
+		return nil, scm.ErrNotSupported
+	}
+	return s.rebase(ctx, repo, number, opts.SHA)
+}
+
+// RebasePullRequest rebases the merge request branch onto the
+// target branch. The rebase runs in the background, so conflicts
+// are only detected upfront if the merge request already reports
+// them.
+func (s *pullService) RebasePullRequest(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return s.rebase(ctx, repo, number, "")
+}
+
+// rebase rebases the merge request branch, if its head matches the
+// given sha. The head is read before the rebase, so the check is
+// not atomic.
+func (s *pullService) rebase(ctx context.Context, repo string, number int, sha string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number)
+	out := new(struct {
+		Sha          string `json:"sha"`
+		HasConflicts bool   `json:"has_conflicts"`
+	})
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	if sha != "" && out.Sha != sha {
+		return res, scm.ErrRefMismatch
+	}
+	if out.HasConflicts {
+		return res, scm.MergeConflict{Message: fmt.Sprintf("cannot rebase merge request %d", number)}
+	}
+	return s.client.do(ctx, "PUT", path+"/rebase", nil, nil)
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d?state_event=closed", encode(repo), number)
 	res, err := s.client.do(ctx, "PUT", path, nil, nil)
@@ -209,17 +320,67 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return s.UnassignIssue(ctx, repo, number, logins)
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// ListReviewers returns the reviewers of the merge request. GitLab
+// does not report when the review happened.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/reviewers", encode(repo), number)
+	out := []*reviewer{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertReviewerList(out), res, err
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s/merge_requests", encode(repo))
+	title := input.Title
+	if input.Draft {
+		title = draftTitle(title, true)
+	}
 	in := &prInput{
-		Title:        input.Title,
-		SourceBranch: input.Head,
-		TargetBranch: input.Base,
-		Description:  input.Body,
+		Title:              title,
+		SourceBranch:       input.Head,
+		TargetBranch:       input.Base,
+		Description:        input.Body,
+		Labels:             strings.Join(input.Labels, ","),
+		MilestoneID:        input.Milestone,
+		AllowCollaboration: input.MaintainerCanModify,
+		Squash:             input.Squash,
+		RemoveSourceBranch: input.RemoveSourceBranch,
+	}
+	// a merge request from a fork is created in the source
+	// project, targeting the upstream project.
+	if owner, branch := scm.SplitHead(input.Head); owner != "" {
+		target, res, err := s.client.Repositories.Find(ctx, repo)
+		if err != nil {
+			return nil, res, err
+		}
+		id, err := strconv.Atoi(target.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, name := scm.Split(repo)
+		path = fmt.Sprintf("api/v4/projects/%s/merge_requests", encode(scm.Join(owner, name)))
+		in.SourceBranch = branch
+		in.TargetProjectID = id
+	}
+	var res *scm.Response
+	var err error
+	if in.AssigneeIDs, res, err = s.findUserIDs(ctx, input.Assignees); err != nil {
+		return nil, res, err
+	}
+	if in.ReviewerIDs, res, err = s.findUserIDs(ctx, input.Reviewers); err != nil {
+		return nil, res, err
 	}
 
 	out := new(pr)
-	res, err := s.client.do(ctx, "POST", path, in, out)
+	res, err = s.client.do(ctx, "POST", path, in, out)
 	if err != nil {
 		return nil, res, err
 	}
@@ -231,7 +392,11 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 }
 
 func (s *pullService) Update(ctx context.Context, repo string, number int, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
-	updateOpts := &updateMergeRequestOptions{}
+	updateOpts := &updateMergeRequestOptions{
+		AllowCollaboration: input.MaintainerCanModify,
+		Squash:             input.Squash,
+		RemoveSourceBranch: input.RemoveSourceBranch,
+	}
 	if input.Title != "" {
 		updateOpts.Title = &input.Title
 	}
@@ -241,9 +406,71 @@ func (s *pullService) Update(ctx context.Context, repo string, number int, input
 	if input.Base != "" {
 		updateOpts.TargetBranch = &input.Base
 	}
+	if len(input.Labels) != 0 {
+		labels := strings.Join(input.Labels, ",")
+		updateOpts.Labels = &labels
+	}
+	if input.Milestone != 0 {
+		updateOpts.MilestoneID = &input.Milestone
+	}
+	var res *scm.Response
+	var err error
+	if updateOpts.AssigneeIDs, res, err = s.findUserIDs(ctx, input.Assignees); err != nil {
+		return nil, res, err
+	}
+	if updateOpts.ReviewerIDs, res, err = s.updateReviewerIDs(ctx, repo, number, input.Reviewers); err != nil {
+		return nil, res, err
+	}
 	return s.updateMergeRequestField(ctx, repo, number, updateOpts)
 }
 
+// updateReviewerIDs returns the ids of the current reviewers of the
+// merge request together with those of the given logins, since the
+// reviewer ids replace the existing reviewers.
+func (s *pullService) updateReviewerIDs(ctx context.Context, repo string, number int, logins []string) ([]int, *scm.Response, error) {
+	if len(logins) == 0 {
+		return nil, nil, nil
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/reviewers", encode(repo), number)
+	out := []*reviewer{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	added, res, err := s.findUserIDs(ctx, logins)
+	if err != nil {
+		return nil, res, err
+	}
+	var ids []int
+	seen := map[int]bool{}
+	for _, v := range out {
+		if !seen[v.User.ID] {
+			seen[v.User.ID] = true
+			ids = append(ids, v.User.ID)
+		}
+	}
+	for _, id := range added {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, res, nil
+}
+
+// findUserIDs looks up the ids of the users with the given logins.
+func (s *pullService) findUserIDs(ctx context.Context, logins []string) ([]int, *scm.Response, error) {
+	var ids []int
+	for _, login := range logins {
+		u, res, err := s.client.Users.FindLogin(ctx, login)
+		if err != nil {
+			return nil, res, err
+		}
+		ids = append(ids, u.ID)
+	}
+	return ids, nil, nil
+}
+
 func (s *pullService) SetMilestone(ctx context.Context, repo string, prID int, number int) (*scm.Response, error) {
 	updateOpts := &updateMergeRequestOptions{
 		MilestoneID: &number,
@@ -267,6 +494,7 @@ type updateMergeRequestOptions struct {
 	TargetBranch       *string `json:"target_branch,omitempty"`
 	AssigneeID         *int    `json:"assignee_id,omitempty"`
 	AssigneeIDs        []int   `json:"assignee_ids,omitempty"`
+	ReviewerIDs        []int   `json:"reviewer_ids,omitempty"`
 	Labels             *string `json:"labels,omitempty"`
 	MilestoneID        *int    `json:"milestone_id,omitempty"`
 	StateEvent         *string `json:"state_event,omitempty"`
@@ -310,11 +538,22 @@ type pr struct {
 	Updated         time.Time `json:"updated_at"`
 	Closed          time.Time
 	DiffRefs        struct {
-		BaseSHA string `json:"base_sha"`
-		HeadSHA string `json:"head_sha"`
+		BaseSHA  string `json:"base_sha"`
+		HeadSHA  string `json:"head_sha"`
+		StartSHA string `json:"start_sha"`
 	} `json:"diff_refs"`
 	Assignee  *user   `json:"assignee"`
 	Assignees []*user `json:"assignees"`
+
+	MergeCommitSha            string     `json:"merge_commit_sha"`
+	SquashCommitSha           string     `json:"squash_commit_sha"`
+	MergedBy                  *user      `json:"merged_by"`
+	MergeUser                 *user      `json:"merge_user"`
+	MergedAt                  *time.Time `json:"merged_at"`
+	ClosedAt                  *time.Time `json:"closed_at"`
+	ChangesCount              string     `json:"changes_count"`
+	MergeWhenPipelineSucceeds bool       `json:"merge_when_pipeline_succeeds"`
+	DetailedMergeStatus       string     `json:"detailed_merge_status"`
 }
 
 type changes struct {
@@ -330,11 +569,24 @@ type change struct {
 	Diff    string `json:"diff"`
 }
 
+type reviewer struct {
+	User  user   `json:"user"`
+	State string `json:"state"`
+}
+
 type prInput struct {
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	SourceBranch string `json:"source_branch"`
-	TargetBranch string `json:"target_branch"`
+	Title              string `json:"title"`
+	Description        string `json:"description"`
+	SourceBranch       string `json:"source_branch"`
+	TargetBranch       string `json:"target_branch"`
+	TargetProjectID    int    `json:"target_project_id,omitempty"`
+	AssigneeIDs        []int  `json:"assignee_ids,omitempty"`
+	ReviewerIDs        []int  `json:"reviewer_ids,omitempty"`
+	Labels             string `json:"labels,omitempty"`
+	MilestoneID        int    `json:"milestone_id,omitempty"`
+	AllowCollaboration *bool  `json:"allow_collaboration,omitempty"`
+	Squash             *bool  `json:"squash,omitempty"`
+	RemoveSourceBranch *bool  `json:"remove_source_branch,omitempty"`
 }
 
 type pullRequestMergeRequest struct {
@@ -395,7 +647,7 @@ func (s *pullService) convertPullRequest(ctx context.Context, from *pr) (*scm.Pu
 	if err != nil {
 		return nil, res, err
 	}
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number:         from.Number,
 		Title:          from.Title,
 		Body:           from.Desc,
@@ -423,10 +675,54 @@ func (s *pullService) convertPullRequest(ctx context.Context, from *pr) (*scm.Pu
 			Sha:  from.DiffRefs.BaseSHA,
 			Repo: *baseRepo,
 		},
-		Created: from.Created,
-		Updated: from.Updated,
-		Fork:    sourceRepo.PathNamespace,
-	}, nil, nil
+		Created:        from.Created,
+		Updated:        from.Updated,
+		Fork:           sourceRepo.PathNamespace,
+		FromFork:       from.SourceProjectID != from.TargetProjectID,
+		MergeSha:       from.MergeCommitSha,
+		MergedAt:       from.MergedAt,
+		ClosedAt:       from.ClosedAt,
+		ReviewDecision: convertReviewDecision(from.DetailedMergeStatus),
+	}
+	if to.MergeSha == "" {
+		to.MergeSha = from.SquashCommitSha
+	}
+	// the number of changes is a string, such as "1000+" when
+	// the changes exceed the diff limits.
+	to.ChangedFiles, _ = strconv.Atoi(from.ChangesCount)
+	// merged_by is deprecated in favour of merge_user.
+	if from.MergeUser != nil {
+		to.MergedBy = convertUser(from.MergeUser)
+	} else if from.MergedBy != nil {
+		to.MergedBy = convertUser(from.MergedBy)
+	}
+	if !to.Merged {
+		to.MergedBy = nil
+	}
+	if from.MergeWhenPipelineSucceeds {
+		to.AutoMerge = &scm.AutoMerge{}
+		if from.MergeUser != nil {
+			to.AutoMerge.EnabledBy = *convertUser(from.MergeUser)
+		}
+	}
+	return to, nil, nil
+}
+
+// convertReviewDecision converts the detailed merge status. A
+// mergeable merge request has passed the approval check, while the
+// other statuses only report missing approvals and requested
+// changes.
+func convertReviewDecision(status string) scm.ReviewDecision {
+	switch status {
+	case "mergeable":
+		return scm.ReviewDecisionApproved
+	case "not_approved":
+		return scm.ReviewDecisionReviewRequired
+	case "requested_changes":
+		return scm.ReviewDecisionChangesRequested
+	default:
+		return scm.ReviewDecisionUnknown
+	}
 }
 
 func (s *pullService) getSourceFork(ctx context.Context, from *pr) (repository, error) {
@@ -472,3 +768,30 @@ func convertChange(from *change) *scm.Change {
 	}
 	return to
 }
+
+func convertReviewerList(from []*reviewer) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	for _, v := range from {
+		to = append(to, &scm.Reviewer{
+			Kind:  scm.ReviewerKindUser,
+			User:  *convertUser(&v.User),
+			State: convertReviewerState(v.State),
+		})
+	}
+	return to
+}
+
+// convertReviewerState converts the state of a merge request
+// reviewer to a review state.
+func convertReviewerState(from string) string {
+	switch from {
+	case "approved":
+		return scm.ReviewStateApproved
+	case "requested_changes":
+		return scm.ReviewStateChangesRequested
+	case "reviewed":
+		return scm.ReviewStateCommented
+	default:
+		return scm.ReviewStatePending
+	}
+}
@@ -129,6 +129,40 @@ func TestPullList(t *testing.T) {
 	t.Run("Page", testPage(res))
 }
 
+func TestPullListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/commits").
+		MatchParam("page", "1").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/commits.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListCommits(context.Background(), "diaspora/diaspora", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+	t.Run("Page", testPage(res))
+}
+
 func TestPullListChanges(t *testing.T) {
 	gock.New("https://gitlab.com").
 		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/changes").
@@ -158,6 +192,38 @@ func TestPullListChanges(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullListChangesDiffs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/diffs").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		SetHeaders(mockPageHeaders).
+		File("testdata/merge_diffs.json")
+
+	client := NewDefault()
+	client.SetServerInfo(&scm.ServerInfo{Product: "gitlab", Version: "15.7.0"})
+	got, res, err := client.PullRequests.ListChanges(context.Background(), "diaspora/diaspora", 1347, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/merge_diff.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestPullMerge(t *testing.T) {
 	defer gock.Off()
 
@@ -178,6 +244,210 @@ func TestPullMerge(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullEnableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/merge").
+		JSON(map[string]string{
+			"squash_commit_message":        "Add a feature\n\nSigned-off-by: Jane Doe",
+			"squash":                       "true",
+			"merge_when_pipeline_succeeds": "true",
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	opts := &scm.AutoMergeOptions{
+		MergeMethod:   "squash",
+		CommitTitle:   "Add a feature",
+		CommitMessage: "Signed-off-by: Jane Doe",
+	}
+	res, err := client.PullRequests.EnableAutoMerge(context.Background(), "diaspora/diaspora", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullEnableAutoMergeRebase(t *testing.T) {
+	client := NewDefault()
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "diaspora/diaspora", 1347, &scm.AutoMergeOptions{MergeMethod: "rebase"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullDisableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/cancel_merge_when_pipeline_succeeds").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.DisableAutoMerge(context.Background(), "diaspora/diaspora", 1347)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullSetDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		JSON(map[string]string{"title": "Draft: JS fix"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.PullRequests.SetDraft(context.Background(), "diaspora/diaspora", 1347, true)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullSetDraft_Unchanged(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	_, err := client.PullRequests.SetDraft(context.Background(), "diaspora/diaspora", 1347, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func Test_draftTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		draft bool
+		want  string
+	}{
+		{title: "JS fix", draft: true, want: "Draft: JS fix"},
+		{title: "[Draft] JS fix", draft: true, want: "[Draft] JS fix"},
+		{title: "Draft: JS fix", draft: false, want: "JS fix"},
+		{title: "(draft) JS fix", draft: false, want: "JS fix"},
+		{title: "Draft - JS fix", draft: false, want: "JS fix"},
+		{title: "Drafting the JS fix", draft: false, want: "Drafting the JS fix"},
+	}
+	for _, test := range tests {
+		if got := draftTitle(test.title, test.draft); got != test.want {
+			t.Errorf("Want title %q, got %q", test.want, got)
+		}
+	}
+}
+
+func TestPullRebase(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/rebase").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"rebase_in_progress":true}`)
+
+	client := NewDefault()
+	opts := &scm.PullRequestUpdateBranchOptions{Method: "rebase"}
+	res, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "diaspora/diaspora", 1347, opts)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRebase_Conflict(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"iid":1347,"has_conflicts":true}`)
+
+	client := NewDefault()
+	_, err := client.PullRequests.RebasePullRequest(context.Background(), "diaspora/diaspora", 1347)
+	if !scm.IsMergeConflict(err) {
+		t.Errorf("Want merge conflict error, got %v", err)
+	}
+}
+
+func TestPullRebase_ShaMismatch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge.json")
+
+	client := NewDefault()
+	opts := &scm.PullRequestUpdateBranchOptions{Method: "rebase", SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e"}
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "diaspora/diaspora", 1347, opts)
+	if err != scm.ErrRefMismatch {
+		t.Errorf("Want ErrRefMismatch, got %v", err)
+	}
+}
+
+func TestPullUpdateBranch_Merge(t *testing.T) {
+	client := NewDefault()
+	_, err := client.PullRequests.UpdatePullRequestBranch(context.Background(), "diaspora/diaspora", 1347, nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -431,6 +701,128 @@ func TestPullCreate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullCreateFromFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/john_smith/diaspora/merge_requests").
+		JSON(map[string]interface{}{
+			"title":                "Amazing new feature",
+			"description":          "",
+			"source_branch":        "test1",
+			"target_branch":        "master",
+			"target_project_id":    32732,
+			"assignee_ids":         []int{1},
+			"reviewer_ids":         []int{1},
+			"labels":               "bug,ui",
+			"milestone_id":         1,
+			"allow_collaboration":  true,
+			"squash":               true,
+			"remove_source_branch": true,
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/32732").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/2").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/other_repo.json")
+
+	enabled := true
+	input := &scm.PullRequestInput{
+		Title:               "Amazing new feature",
+		Head:                "john_smith:test1",
+		Base:                "master",
+		Labels:              []string{"bug", "ui"},
+		Assignees:           []string{"john_smith"},
+		Reviewers:           []string{"john_smith"},
+		Milestone:           1,
+		MaintainerCanModify: &enabled,
+		Squash:              &enabled,
+		RemoveSourceBranch:  &enabled,
+	}
+
+	client := NewDefault()
+	_, _, err := client.PullRequests.Create(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullCreateDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/32732").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/2").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/other_repo.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests").
+		BodyString(`"title":"Draft: Amazing new feature"`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	input := &scm.PullRequestInput{
+		Title: "Amazing new feature",
+		Head:  "test1",
+		Base:  "master",
+		Draft: true,
+	}
+
+	client := NewDefault()
+	_, _, err := client.PullRequests.Create(context.Background(), "diaspora/diaspora", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestPullUpdate(t *testing.T) {
 	defer gock.Off()
 
@@ -487,6 +879,107 @@ func TestPullUpdate(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestPullUpdateFields(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/users").
+		MatchParam("search", "john_smith").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user_search.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_reviewers.json")
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1").
+		JSON(map[string]interface{}{
+			"reviewer_ids": []int{1, 2},
+			"labels":       "bug",
+			"milestone_id": 1,
+			"squash":       false,
+		}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/pr_create.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/32732").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/2").
+		Times(2).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/other_repo.json")
+
+	squash := false
+	input := &scm.PullRequestInput{
+		Labels:    []string{"bug"},
+		Reviewers: []string{"john_smith"},
+		Milestone: 1,
+		Squash:    &squash,
+	}
+
+	client := NewDefault()
+	_, _, err := client.PullRequests.Update(context.Background(), "diaspora/diaspora", 1, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1/reviewers").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_reviewers.json")
+
+	client := NewDefault()
+	got, res, err := client.PullRequests.ListReviewers(context.Background(), "diaspora/diaspora", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := ioutil.ReadFile("testdata/merge_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestPullRequestTeamReview(t *testing.T) {
+	client := NewDefault()
+	_, err := client.PullRequests.RequestTeamReview(context.Background(), "diaspora/diaspora", 1, []string{"devs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestPullListEvents(t *testing.T) {
 	defer gock.Off()
 
@@ -601,3 +1094,20 @@ func TestPullClearMilestone(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestConvertReviewDecision(t *testing.T) {
+	tests := []struct {
+		status string
+		want   scm.ReviewDecision
+	}{
+		{"mergeable", scm.ReviewDecisionApproved},
+		{"not_approved", scm.ReviewDecisionReviewRequired},
+		{"requested_changes", scm.ReviewDecisionChangesRequested},
+		{"ci_still_running", scm.ReviewDecisionUnknown},
+	}
+	for _, test := range tests {
+		if got := convertReviewDecision(test.status); got != test.want {
+			t.Errorf("Want review decision %q for status %q, got %q", test.want, test.status, got)
+		}
+	}
+}
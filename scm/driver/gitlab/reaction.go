@@ -0,0 +1,136 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// reactionService implements the reactions with the award emoji
+// of the issues, merge requests and notes.
+//
+// See https://docs.gitlab.com/ee/api/award_emoji.html
+type reactionService struct {
+	client *wrapper
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number), opts)
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number), emoji)
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/award_emoji", encode(repo), number), emoji)
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id), opts)
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id), emoji)
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("api/v4/projects/%s/issues/%d/notes/%d/award_emoji", encode(repo), number, id), emoji)
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number), opts)
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number), emoji)
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/award_emoji", encode(repo), number), emoji)
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return s.list(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id), opts)
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return s.create(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id), emoji)
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return s.delete(ctx, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/notes/%d/award_emoji", encode(repo), number, id), emoji)
+}
+
+func (s *reactionService) list(ctx context.Context, path string, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	path = fmt.Sprintf("%s?%s", path, encodeListOptions(opts))
+	out := []*award{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertAwardList(out), res, err
+}
+
+func (s *reactionService) create(ctx context.Context, path string, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	in := &awardInput{Name: emoji.Shortcode()}
+	out := new(award)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAward(out), res, err
+}
+
+// delete deletes the award emoji of the authenticated user with the
+// given name, which are found by listing the award emoji.
+func (s *reactionService) delete(ctx context.Context, path string, emoji scm.Emoji) (*scm.Response, error) {
+	user, res, err := s.client.Users.Find(ctx)
+	if err != nil {
+		return res, err
+	}
+	out := []*award{}
+	res, err = s.client.do(ctx, "GET", path+"?per_page=100", nil, &out)
+	if err != nil {
+		return res, err
+	}
+	for _, v := range out {
+		if v.User.ID != user.ID || scm.ToEmoji(v.Name) != emoji {
+			continue
+		}
+		res, err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", path, v.ID), nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+type award struct {
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	User    user      `json:"user"`
+	Created time.Time `json:"created_at"`
+}
+
+type awardInput struct {
+	Name string `json:"name"`
+}
+
+func convertAwardList(from []*award) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from {
+		to = append(to, convertAward(v))
+	}
+	return to
+}
+
+func convertAward(from *award) *scm.Reaction {
+	return &scm.Reaction{
+		ID:      from.ID,
+		Emoji:   scm.ToEmoji(from.Name),
+		Content: from.Name,
+		User:    *convertUser(&from.User),
+		Created: from.Created,
+	}
+}
@@ -111,6 +111,13 @@ type label struct {
 	Description string `json:"description"`
 }
 
+type labelInput struct {
+	Name        string `json:"name,omitempty"`
+	NewName     string `json:"new_name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
 type member struct {
 	ID          int    `json:"id"`
 	Username    string `json:"username"`
@@ -296,6 +303,37 @@ func (s *repositoryService) ListLabels(ctx context.Context, repo string, opts sc
 	return convertLabelObjects(out), res, err
 }
 
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels", encode(repo))
+	in := &labelInput{
+		Name:        input.Name,
+		Color:       labelColor(input.Color),
+		Description: input.Description,
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	in := &labelInput{
+		Color:       labelColor(input.Color),
+		Description: input.Description,
+	}
+	if input.Name != name {
+		in.NewName = input.Name
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/labels/%s", encode(repo), url.PathEscape(name))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
 	out := new(repository)
@@ -470,6 +508,12 @@ func (s *repositoryService) Delete(context.Context, string) (*scm.Response, erro
 	return nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) SetDefaultBranch(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s", encode(repo))
+	in := map[string]string{"default_branch": branch}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -630,6 +674,15 @@ func convertLabelObjects(from []*label) []*scm.Label {
 	return labels
 }
 
+// labelColor returns the hex color code with the leading # which
+// is required by GitLab.
+func labelColor(color string) string {
+	if color == "" || strings.HasPrefix(color, "#") {
+		return color
+	}
+	return "#" + color
+}
+
 func convertLabel(from *label) *scm.Label {
 	return &scm.Label{
 		ID:          int64(from.ID),
@@ -509,6 +509,28 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositorySetDefaultBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora").
+		JSON(map[string]string{"default_branch": "develop"}).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/repo.json")
+
+	client := NewDefault()
+	res, err := client.Repositories.SetDefaultBranch(context.Background(), "diaspora/diaspora", "develop")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestRepositoryHookCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -857,3 +879,83 @@ func TestCanAdmin(t *testing.T) {
 		}
 	}
 }
+
+func TestRepositoryCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/labels").
+		BodyString(`{"name":"feature","color":"#5843AD","description":"New functionality"}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	in := &scm.LabelInput{
+		Name:        "feature",
+		Color:       "5843AD",
+		Description: "New functionality",
+	}
+
+	client := NewDefault()
+	got, res, err := client.Repositories.CreateLabel(context.Background(), "diaspora/diaspora", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Label)
+	raw, _ := ioutil.ReadFile("testdata/label.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/labels/enhancement").
+		BodyString(`{"new_name":"feature","description":"New functionality"}`).
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/label.json")
+
+	client := NewDefault()
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "diaspora/diaspora", "enhancement", &scm.LabelInput{Name: "feature", Description: "New functionality"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.Name != "feature" {
+		t.Errorf("Want label name feature, got %s", got.Name)
+	}
+}
+
+func TestRepositoryDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Delete("/api/v4/projects/diaspora/diaspora/labels/feature").
+		Reply(204).
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.DeleteLabel(context.Background(), "diaspora/diaspora", "feature")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
@@ -6,6 +6,12 @@ package gitlab
 
 import (
 	"context"
+	"crypto/sha1"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -45,3 +51,289 @@ func (s *reviewService) Submit(ctx context.Context, repo string, prID int, revie
 func (s *reviewService) Dismiss(ctx context.Context, repo string, prID int, reviewID int, msg string) (*scm.Review, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+// CreateComment starts a discussion on the diff of the merge request.
+// Multi-line comments are anchored to the last line and cover the
+// line range, whose lines are looked up in the diff of the file.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.ReviewComment, *scm.Response, error) {
+	mr := new(pr)
+	res, err := s.client.do(ctx, "GET", fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", encode(repo), number), nil, mr)
+	if err != nil {
+		return nil, res, err
+	}
+	info := "suggestion"
+	if input.StartLine > 0 && input.StartLine < input.Line {
+		info = fmt.Sprintf("suggestion:-%d+0", input.Line-input.StartLine)
+	}
+	in := &discussionInput{
+		Body: scm.FormatSuggestion(input.Body, input.Suggestion, info),
+		Position: &notePosition{
+			PositionType: "text",
+			BaseSHA:      mr.DiffRefs.BaseSHA,
+			StartSHA:     mr.DiffRefs.StartSHA,
+			HeadSHA:      mr.DiffRefs.HeadSHA,
+			OldPath:      input.Path,
+			NewPath:      input.Path,
+		},
+	}
+	if input.Side == scm.SideLeft {
+		in.Position.OldLine = input.Line
+	} else {
+		in.Position.NewLine = input.Line
+	}
+	if input.StartLine > 0 && input.StartLine < input.Line {
+		startSide := input.StartSide
+		if startSide == "" {
+			startSide = input.Side
+		}
+		patch, res, err := s.findPatch(ctx, repo, number, input.Path)
+		if err != nil {
+			return nil, res, err
+		}
+		start := findDiffLine(patch, input.StartLine, startSide)
+		end := findDiffLine(patch, input.Line, input.Side)
+		in.Position.OldLine, in.Position.NewLine = end.Old, end.New
+		in.Position.LineRange = &lineRange{
+			Start: newLineRangeEnd(input.Path, start),
+			End:   newLineRangeEnd(input.Path, end),
+		}
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions", encode(repo), number)
+	out := new(discussion)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Notes) == 0 {
+		return nil, res, fmt.Errorf("discussion %s has no notes", out.ID)
+	}
+	return convertDiscussionNote(out, out.Notes[0]), res, nil
+}
+
+// ReplyToComment adds a note to the discussion of the comment.
+func (s *reviewService) ReplyToComment(ctx context.Context, repo string, number, id int, body string) (*scm.ReviewComment, *scm.Response, error) {
+	thread, res, err := s.findDiscussion(ctx, repo, number, id)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s/notes", encode(repo), number, thread.ID)
+	in := &discussionInput{Body: body}
+	out := new(note)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertDiscussionNote(thread, out), res, nil
+}
+
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions/%s?resolved=true", encode(repo), number, thread)
+	return s.client.do(ctx, "PUT", path, nil, nil)
+}
+
+// findDiscussion returns the discussion of the merge request holding
+// the note.
+func (s *reviewService) findDiscussion(ctx context.Context, repo string, number, id int) (*discussion, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		path := fmt.Sprintf("api/v4/projects/%s/merge_requests/%d/discussions?%s", encode(repo), number, encodeListOptions(opts))
+		out := []*discussion{}
+		res, err := s.client.do(ctx, "GET", path, nil, &out)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, thread := range out {
+			for _, v := range thread.Notes {
+				if v.ID == id {
+					return thread, res, nil
+				}
+			}
+		}
+		if res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+type discussion struct {
+	ID    string  `json:"id"`
+	Notes []*note `json:"notes"`
+}
+
+type note struct {
+	ID       int           `json:"id"`
+	Body     string        `json:"body"`
+	Author   user          `json:"author"`
+	Created  time.Time     `json:"created_at"`
+	Updated  time.Time     `json:"updated_at"`
+	Resolved bool          `json:"resolved"`
+	Position *notePosition `json:"position"`
+}
+
+type notePosition struct {
+	PositionType string     `json:"position_type"`
+	BaseSHA      string     `json:"base_sha"`
+	StartSHA     string     `json:"start_sha"`
+	HeadSHA      string     `json:"head_sha"`
+	OldPath      string     `json:"old_path"`
+	NewPath      string     `json:"new_path"`
+	OldLine      int        `json:"old_line,omitempty"`
+	NewLine      int        `json:"new_line,omitempty"`
+	LineRange    *lineRange `json:"line_range,omitempty"`
+}
+
+type lineRange struct {
+	Start lineRangeEnd `json:"start"`
+	End   lineRangeEnd `json:"end"`
+}
+
+type lineRangeEnd struct {
+	LineCode string `json:"line_code,omitempty"`
+	Type     string `json:"type,omitempty"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
+}
+
+// newLineRangeEnd returns the end of a line range at the line of
+// the diff. The line code is the sha1 of the path followed by the
+// old and the new line, and unchanged lines have no type.
+func newLineRangeEnd(path string, line diffLine) lineRangeEnd {
+	to := lineRangeEnd{OldLine: line.Old, NewLine: line.New}
+	switch {
+	case line.Old == 0:
+		to.Type = "new"
+	case line.New == 0:
+		to.Type = "old"
+	}
+	to.LineCode = fmt.Sprintf("%x_%d_%d", sha1.Sum([]byte(path)), line.Old, line.New)
+	return to
+}
+
+// findPatch returns the diff of the file in the merge request, or
+// an empty diff if the file is not changed.
+func (s *reviewService) findPatch(ctx context.Context, repo string, number int, path string) (string, *scm.Response, error) {
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out, res, err := s.client.PullRequests.ListChanges(ctx, repo, number, opts)
+		if err != nil {
+			return "", res, err
+		}
+		for _, v := range out {
+			if v.Path == path {
+				return v.Patch, res, nil
+			}
+		}
+		if res.Page.Next == 0 {
+			return "", res, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+// diffLine is a line of a diff with its numbers in the old and the
+// new file. Added lines have no old line and removed lines have no
+// new line.
+type diffLine struct {
+	Old int
+	New int
+}
+
+var hunkRe = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// findDiffLine returns the line of the patch on the given side of the
+// diff. Lines outside of the hunks are unchanged and offset by the
+// lines added and removed before them.
+func findDiffLine(patch string, line int, side string) diffLine {
+	left := side == scm.SideLeft
+	var old, cur int // the last lines seen in the old and new file
+	// unchanged returns the unchanged line if it is between the
+	// last lines seen and the given start of the next hunk.
+	unchanged := func(oldStart, newStart int) (diffLine, bool) {
+		switch {
+		case left && line > old && line < oldStart:
+			return diffLine{Old: line, New: line + cur - old}, true
+		case !left && line > cur && line < newStart:
+			return diffLine{Old: line - cur + old, New: line}, true
+		}
+		return diffLine{}, false
+	}
+	hunk := false
+	for _, text := range strings.Split(patch, "\n") {
+		if m := hunkRe.FindStringSubmatch(text); m != nil {
+			oldStart, _ := strconv.Atoi(m[1])
+			newStart, _ := strconv.Atoi(m[2])
+			if to, ok := unchanged(oldStart, newStart); ok {
+				return to
+			}
+			old, cur, hunk = oldStart-1, newStart-1, true
+			continue
+		}
+		if !hunk {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(text, "+"):
+			cur++
+			if !left && cur == line {
+				return diffLine{New: cur}
+			}
+		case strings.HasPrefix(text, "-"):
+			old++
+			if left && old == line {
+				return diffLine{Old: old}
+			}
+		case strings.HasPrefix(text, " "):
+			old++
+			cur++
+			if (left && old == line) || (!left && cur == line) {
+				return diffLine{Old: old, New: cur}
+			}
+		}
+	}
+	if to, ok := unchanged(line+1, line+1); ok {
+		return to
+	}
+	if left {
+		return diffLine{Old: line}
+	}
+	return diffLine{New: line}
+}
+
+type discussionInput struct {
+	Body     string        `json:"body"`
+	Position *notePosition `json:"position,omitempty"`
+}
+
+func convertDiscussionNote(thread *discussion, from *note) *scm.ReviewComment {
+	to := &scm.ReviewComment{
+		ID:       from.ID,
+		Body:     from.Body,
+		Author:   *convertUser(&from.Author),
+		Created:  from.Created,
+		Updated:  from.Updated,
+		ThreadID: thread.ID,
+		Resolved: from.Resolved,
+	}
+	if len(thread.Notes) != 0 && thread.Notes[0].ID != from.ID {
+		to.InReplyTo = thread.Notes[0].ID
+	}
+	if pos := from.Position; pos != nil {
+		to.Path = pos.NewPath
+		to.Sha = pos.HeadSHA
+		to.Line, to.Side = convertLine(pos.OldLine, pos.NewLine)
+		if pos.LineRange != nil {
+			to.StartLine, to.StartSide = convertLine(pos.LineRange.Start.OldLine, pos.LineRange.Start.NewLine)
+		}
+	}
+	return to
+}
+
+// convertLine returns the line and the side of the diff of a note
+// position, preferring the new line of the unchanged lines.
+func convertLine(oldLine, newLine int) (int, string) {
+	if newLine == 0 && oldLine != 0 {
+		return oldLine, scm.SideLeft
+	}
+	return newLine, scm.SideRight
+}
@@ -6,9 +6,13 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -42,3 +46,234 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_diff_refs.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/diffs").
+		Reply(200).
+		Type("application/json").
+		JSON([]map[string]interface{}{
+			{"old_path": "main.go", "new_path": "main.go", "diff": "@@ -8,2 +8,5 @@\n line8\n line9\n+a\n+b\n+c\n"},
+		})
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		File("testdata/discussion_create.json").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussion.json")
+
+	input := &scm.ReviewCommentInput{
+		Body:       "Use a constant.",
+		Path:       "main.go",
+		Line:       12,
+		StartLine:  10,
+		Suggestion: "const answer = 42",
+	}
+
+	client := NewDefault()
+	client.SetServerInfo(&scm.ServerInfo{Product: "gitlab", Version: "15.7.0"})
+	got, res, err := client.Reviews.CreateComment(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ReviewComment)
+	raw, _ := ioutil.ReadFile("testdata/discussion.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestReviewCreateComment_ContextLines(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_diff_refs.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/diffs").
+		Reply(200).
+		Type("application/json").
+		JSON([]map[string]interface{}{
+			{"old_path": "main.go", "new_path": "main.go", "diff": "@@ -1,3 +1,4 @@\n a\n+b\n c\n d\n"},
+		})
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		JSON(map[string]interface{}{
+			"body": "Simplify.",
+			"position": map[string]interface{}{
+				"position_type": "text",
+				"base_sha":      "c380d3acebd181f13629a25d2e2acca46ffe1e00",
+				"start_sha":     "c380d3acebd181f13629a25d2e2acca46ffe1e00",
+				"head_sha":      "2be7ddb704c7b6b83732fdd5b9f09d5a397b5f8f",
+				"old_path":      "main.go",
+				"new_path":      "main.go",
+				"old_line":      5,
+				"new_line":      6,
+				"line_range": map[string]interface{}{
+					"start": map[string]interface{}{
+						"line_code": "0607f785dfa3c3861b3239f6723eb276d8056461_2_3",
+						"old_line":  2,
+						"new_line":  3,
+					},
+					"end": map[string]interface{}{
+						"line_code": "0607f785dfa3c3861b3239f6723eb276d8056461_5_6",
+						"old_line":  5,
+						"new_line":  6,
+					},
+				},
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussion.json")
+
+	input := &scm.ReviewCommentInput{
+		Body:      "Simplify.",
+		Path:      "main.go",
+		Line:      6,
+		StartLine: 3,
+	}
+
+	client := NewDefault()
+	client.SetServerInfo(&scm.ServerInfo{Product: "gitlab", Version: "15.7.0"})
+	_, _, err := client.Reviews.CreateComment(context.Background(), "diaspora/diaspora", 1347, input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestFindDiffLine(t *testing.T) {
+	patch := "--- a/main.go\n+++ b/main.go\n@@ -2,3 +2,3 @@\n a\n-b\n+c\n d\n@@ -10,2 +10,3 @@\n e\n+f\n g\n"
+	tests := []struct {
+		line int
+		side string
+		want diffLine
+	}{
+		{1, "", diffLine{Old: 1, New: 1}},
+		{2, "", diffLine{Old: 2, New: 2}},
+		{3, "", diffLine{New: 3}},
+		{3, scm.SideLeft, diffLine{Old: 3}},
+		{6, "", diffLine{Old: 6, New: 6}},
+		{11, "", diffLine{New: 11}},
+		{12, "", diffLine{Old: 11, New: 12}},
+		{20, "", diffLine{Old: 19, New: 20}},
+		{20, scm.SideLeft, diffLine{Old: 20, New: 21}},
+	}
+	for _, test := range tests {
+		if got := findDiffLine(patch, test.line, test.side); got != test.want {
+			t.Errorf("Want line %d on side %q to be %+v, got %+v", test.line, test.side, test.want, got)
+		}
+	}
+}
+
+func TestReviewCreateComment_NoNotes(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/merge_diff_refs.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		BodyString(`{"id":"6a9c1750b37d513a43987b574953fceb50b03ce7","notes":[]}`)
+
+	input := &scm.ReviewCommentInput{
+		Body: "Use a constant.",
+		Path: "main.go",
+		Line: 12,
+	}
+
+	client := NewDefault()
+	_, _, err := client.Reviews.CreateComment(context.Background(), "diaspora/diaspora", 1347, input)
+	if err == nil {
+		t.Errorf("Expect error when the discussion has no notes")
+	}
+}
+
+func TestReviewReplyToComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions").
+		MatchParam("page", "1").
+		MatchParam("per_page", "100").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussions.json")
+
+	gock.New("https://gitlab.com").
+		Post("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7/notes").
+		BodyString(`{"body":"Done."}`).
+		Reply(201).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussion_note.json")
+
+	client := NewDefault()
+	got, _, err := client.Reviews.ReplyToComment(context.Background(), "diaspora/diaspora", 1347, 1128, "Done.")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.ID != 1129 || got.InReplyTo != 1128 || got.ThreadID != "6a9c1750b37d513a43987b574953fceb50b03ce7" {
+		t.Errorf("Unexpected reply %+v", got)
+	}
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Put("/api/v4/projects/diaspora/diaspora/merge_requests/1347/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7").
+		MatchParam("resolved", "true").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/discussion.json")
+
+	client := NewDefault()
+	res, err := client.Reviews.ResolveThread(context.Background(), "diaspora/diaspora", 1347, "6a9c1750b37d513a43987b574953fceb50b03ce7")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
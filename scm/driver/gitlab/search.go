@@ -0,0 +1,163 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// searchService searches the project, the group or the instance
+// depending on the options. Code and commits are only searched
+// across projects when advanced search is enabled.
+type searchService struct {
+	client *wrapper
+}
+
+func (s *searchService) Code(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCode, *scm.Response, error) {
+	out := []*searchBlob{}
+	res, err := s.client.do(ctx, "GET", searchPath(opts, "blobs"), nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	repos := newRepositoryCache(s.client)
+	to := []*scm.SearchCode{}
+	for _, v := range out {
+		repo, repoRes, err := repos.find(ctx, v.ProjectID)
+		if err != nil {
+			return nil, repoRes, err
+		}
+		to = append(to, convertSearchCode(v, repo))
+	}
+	return to, res, nil
+}
+
+func (s *searchService) Commits(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.SearchCommit, *scm.Response, error) {
+	out := []*searchCommit{}
+	res, err := s.client.do(ctx, "GET", searchPath(opts, "commits"), nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	repos := newRepositoryCache(s.client)
+	to := []*scm.SearchCommit{}
+	for _, v := range out {
+		repo, repoRes, err := repos.find(ctx, v.ProjectID)
+		if err != nil {
+			return nil, repoRes, err
+		}
+		to = append(to, &scm.SearchCommit{
+			Commit:     *convertCommit(&v.commit),
+			Repository: *repo,
+		})
+	}
+	return to, res, nil
+}
+
+// Repositories searches the projects of the group or the instance.
+// The repository of the options is ignored.
+func (s *searchService) Repositories(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.Repository, *scm.Response, error) {
+	opts.Repo = ""
+	out := []*repository{}
+	res, err := s.client.do(ctx, "GET", searchPath(opts, "projects"), nil, &out)
+	return convertRepositoryList(out), res, err
+}
+
+func (s *searchService) PullRequests(ctx context.Context, opts scm.SearchQueryOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	out := []*pr{}
+	res, err := s.client.do(ctx, "GET", searchPath(opts, "merge_requests"), nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	to, convRes, err := (&pullService{s.client}).convertPullRequestList(ctx, out)
+	if err != nil {
+		return nil, convRes, err
+	}
+	return to, res, nil
+}
+
+// searchPath returns the path of the project, group or global
+// search in the given scope.
+func searchPath(opts scm.SearchQueryOptions, scope string) string {
+	params := url.Values{}
+	params.Set("scope", scope)
+	params.Set("search", opts.Query)
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	if opts.Sort != "" {
+		params.Set("order_by", opts.Sort)
+		if opts.Ascending {
+			params.Set("sort", "asc")
+		} else {
+			params.Set("sort", "desc")
+		}
+	}
+	switch {
+	case opts.Repo != "":
+		return fmt.Sprintf("api/v4/projects/%s/search?%s", encode(opts.Repo), params.Encode())
+	case opts.Org != "":
+		return fmt.Sprintf("api/v4/groups/%s/search?%s", encode(opts.Org), params.Encode())
+	default:
+		return fmt.Sprintf("api/v4/search?%s", params.Encode())
+	}
+}
+
+// repositoryCache finds the projects of the search results, which
+// only include the project identifier.
+type repositoryCache struct {
+	client *wrapper
+	repos  map[int]*scm.Repository
+}
+
+func newRepositoryCache(client *wrapper) *repositoryCache {
+	return &repositoryCache{
+		client: client,
+		repos:  map[int]*scm.Repository{},
+	}
+}
+
+func (c *repositoryCache) find(ctx context.Context, id int) (*scm.Repository, *scm.Response, error) {
+	if repo, ok := c.repos[id]; ok {
+		return repo, nil, nil
+	}
+	repo, res, err := c.client.Repositories.Find(ctx, strconv.Itoa(id))
+	if err != nil {
+		return nil, res, err
+	}
+	c.repos[id] = repo
+	return repo, res, nil
+}
+
+type searchBlob struct {
+	Path      string `json:"path"`
+	Data      string `json:"data"`
+	Ref       string `json:"ref"`
+	StartLine int    `json:"startline"`
+	ProjectID int    `json:"project_id"`
+}
+
+type searchCommit struct {
+	commit
+	ProjectID int `json:"project_id"`
+}
+
+func convertSearchCode(from *searchBlob, repo *scm.Repository) *scm.SearchCode {
+	return &scm.SearchCode{
+		Repository: *repo,
+		Path:       from.Path,
+		Ref:        from.Ref,
+		Link:       fmt.Sprintf("%s/-/blob/%s/%s#L%d", repo.Link, from.Ref, from.Path, from.StartLine),
+		Fragments: []*scm.SearchFragment{
+			{Line: from.StartLine, Text: from.Data},
+		},
+	}
+}
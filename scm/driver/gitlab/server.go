@@ -0,0 +1,45 @@
+package gitlab
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+// Find returns the server details. Enterprise installations report
+// their version with an "-ee" suffix.
+//
+// See https://docs.gitlab.com/ee/api/version.html
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	out := new(version)
+	res, err := s.client.do(ctx, "GET", "api/v4/version", nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertVersion(out, s.client.BaseURL.Host), res, nil
+}
+
+type version struct {
+	Version  string `json:"version"`
+	Revision string `json:"revision"`
+}
+
+func convertVersion(from *version, host string) *scm.ServerInfo {
+	edition := scm.EditionCommunity
+	switch {
+	case host == "gitlab.com":
+		edition = scm.EditionCloud
+	case strings.HasSuffix(from.Version, "-ee"):
+		edition = scm.EditionEnterprise
+	}
+	return &scm.ServerInfo{
+		Product: scm.DriverGitlab.String(),
+		Version: from.Version,
+		Edition: edition,
+	}
+}
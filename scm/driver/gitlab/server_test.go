@@ -0,0 +1,42 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestServerFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.example.com").
+		Get("/api/v4/version").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/version.json")
+
+	client, _ := New("https://gitlab.example.com")
+	got, res, err := client.Server.Find(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ServerInfo)
+	raw, _ := ioutil.ReadFile("testdata/version.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 	"github.com/jenkins-x/go-scm/scm/driver/internal/null"
@@ -32,6 +33,37 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	info := new(scm.TokenInfo)
+	pat := new(personalAccessToken)
+	res, err := s.client.do(ctx, "GET", "api/v4/personal_access_tokens/self", nil, pat)
+	if err == nil {
+		info.Scopes = pat.Scopes
+		info.Type = scm.TokenTypePersonal
+		if pat.ExpiresAt.String != "" {
+			info.Expires, _ = time.Parse("2006-01-02", pat.ExpiresAt.String)
+		}
+	} else {
+		// the token may be an OAuth token rather than a
+		// personal, project or group access token.
+		oauth := new(oauthTokenInfo)
+		res, err = s.client.do(ctx, "GET", "oauth/token/info", nil, oauth)
+		if err != nil {
+			return nil, res, err
+		}
+		info.Scopes = oauth.Scope
+		info.Type = scm.TokenTypeOAuth
+		if oauth.ExpiresIn != nil {
+			info.Expires = time.Unix(oauth.CreatedAt, 0).Add(time.Duration(*oauth.ExpiresIn) * time.Second)
+		}
+	}
+	info.User, res, err = s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	return info, res, nil
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	var resp *scm.Response
 	var err error
@@ -92,6 +124,19 @@ type user struct {
 	Avatar   string      `json:"avatar_url"`
 }
 
+type personalAccessToken struct {
+	ID        int         `json:"id"`
+	Name      string      `json:"name"`
+	Scopes    []string    `json:"scopes"`
+	ExpiresAt null.String `json:"expires_at"`
+}
+
+type oauthTokenInfo struct {
+	Scope     []string `json:"scope"`
+	ExpiresIn *int64   `json:"expires_in"`
+	CreatedAt int64    `json:"created_at"`
+}
+
 func convertUser(from *user) *scm.User {
 	return &scm.User{
 		ID:     from.ID,
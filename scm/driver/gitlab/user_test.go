@@ -143,3 +143,76 @@ func TestUserEmailFind(t *testing.T) {
 	t.Run("Request", testRequest(res))
 	t.Run("Rate", testRate(res))
 }
+
+func TestUserFindToken(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/personal_access_tokens/self").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/token.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	client := NewDefault()
+	got, res, err := client.Users.FindToken(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.TokenInfo)
+	raw, _ := ioutil.ReadFile("testdata/token.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+
+		json.NewEncoder(os.Stdout).Encode(got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestUserFindOAuthToken(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/personal_access_tokens/self").
+		Reply(401)
+
+	gock.New("https://gitlab.com").
+		Get("/oauth/token/info").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"resource_owner_id":1,"scope":["api"],"expires_in":7200,"application":{"uid":"1cb242f495280beb"},"created_at":1633536000}`)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	client := NewDefault()
+	got, _, err := client.Users.FindToken(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Type, scm.TokenTypeOAuth; got != want {
+		t.Errorf("Want token type %s, got %s", want, got)
+	}
+	if got, want := got.Expires.Unix(), int64(1633536000+7200); got != want {
+		t.Errorf("Want expiry %d, got %d", want, got)
+	}
+}
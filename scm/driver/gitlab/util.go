@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -56,6 +57,21 @@ func encodeCommitListOptions(opts scm.CommitListOptions) string {
 	if opts.Ref != "" {
 		params.Set("ref_name", opts.Ref)
 	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	if opts.Author != "" {
+		params.Set("author", opts.Author)
+	}
+	if !opts.Since.IsZero() {
+		params.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+	if !opts.Until.IsZero() {
+		params.Set("until", opts.Until.UTC().Format(time.RFC3339))
+	}
+	if opts.FirstParent {
+		params.Set("first_parent", "true")
+	}
 	return params.Encode()
 }
 
@@ -74,6 +90,52 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	} else if opts.Open {
 		params.Set("state", "opened")
 	}
+	if len(opts.Labels) != 0 {
+		params.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.Assignee != "" {
+		params.Set("assignee_username", opts.Assignee)
+	}
+	if opts.Author != "" {
+		params.Set("author_username", opts.Author)
+	}
+	if opts.UpdatedAfter != nil {
+		params.Set("updated_after", opts.UpdatedAfter.UTC().Format(scm.SearchTimeFormat))
+	}
+	if opts.Sort != "" {
+		params.Set("order_by", encodeIssueSort(opts.Sort))
+		if opts.Ascending {
+			params.Set("sort", "asc")
+		} else {
+			params.Set("sort", "desc")
+		}
+	}
+	return params.Encode()
+}
+
+// encodeIssueSort returns the GitLab order_by value for the sort
+// field, passing through native values.
+func encodeIssueSort(sort string) string {
+	switch sort {
+	case "created":
+		return "created_at"
+	case "updated":
+		return "updated_at"
+	default:
+		return sort
+	}
+}
+
+func encodeMergeTrainListOptions(opts scm.ListOptions) string {
+	params := url.Values{}
+	params.Set("scope", "active")
+	params.Set("sort", "asc")
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
 	return params.Encode()
 }
 
@@ -6,6 +6,7 @@ package gitlab
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -34,6 +35,18 @@ func Test_encodeMemberListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeMergeTrainListOptions(t *testing.T) {
+	opts := scm.ListOptions{
+		Page: 10,
+		Size: 30,
+	}
+	want := "page=10&per_page=30&scope=active&sort=asc"
+	got := encodeMergeTrainListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeCommitListOptions(t *testing.T) {
 	opts := scm.CommitListOptions{
 		Page: 10,
@@ -47,6 +60,22 @@ func Test_encodeCommitListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeCommitListOptions_Filters(t *testing.T) {
+	opts := scm.CommitListOptions{
+		Ref:         "master",
+		Path:        "docs/README.md",
+		Author:      "octocat",
+		Since:       time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC),
+		Until:       time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC),
+		FirstParent: true,
+	}
+	want := "author=octocat&first_parent=true&path=docs%2FREADME.md&ref_name=master&since=2018-09-01T00%3A00%3A00Z&until=2018-10-01T00%3A00%3A00Z"
+	got := encodeCommitListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded commit list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -61,6 +90,23 @@ func Test_encodeIssueListOptions(t *testing.T) {
 	}
 }
 
+func Test_encodeIssueListOptions_Filters(t *testing.T) {
+	since := time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC)
+	opts := scm.IssueListOptions{
+		Open:         true,
+		Labels:       []string{"kind/bug", "priority/high"},
+		Assignee:     "lennie",
+		Author:       "root",
+		UpdatedAfter: &since,
+		Sort:         "updated",
+	}
+	want := "assignee_username=lennie&author_username=root&labels=kind%2Fbug%2Cpriority%2Fhigh&order_by=updated_at&sort=desc&state=opened&updated_after=2018-09-01T00%3A00%3A00Z"
+	got := encodeIssueListOptions(opts)
+	if got != want {
+		t.Errorf("Want encoded issue list options %q, got %q", want, got)
+	}
+}
+
 func Test_encodeIssueListOptions_Opened(t *testing.T) {
 	opts := scm.IssueListOptions{
 		Page:   10,
@@ -0,0 +1,27 @@
+package gogs
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+func (s *approvalService) ListRules(context.Context, string, int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *approvalService) FindStatus(context.Context, string, int) (*scm.ApprovalStatus, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *approvalService) Approve(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *approvalService) Unapprove(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
@@ -24,6 +24,10 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -35,6 +39,14 @@ func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Re
 	return convertBranch(out), res, err
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/commits/%s", repo, ref)
 	out := new(commitDetail)
@@ -46,6 +58,10 @@ func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Refer
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) FindTagObject(ctx context.Context, repo, name string) (*scm.Tag, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/branches", repo)
 	out := []*branch{}
@@ -69,6 +85,38 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -95,6 +143,9 @@ type (
 		Sha       string    `json:"sha"`
 		Commit    commit    `json:"commit"`
 		Committer committer `json:"committer"`
+		Parents   []struct {
+			Sha string `json:"sha"`
+		} `json:"parents"`
 	}
 
 	// gogs committer object.
@@ -142,13 +193,17 @@ func convertBranch(src *branch) *scm.Reference {
 // }
 
 func convertCommit(src *commitDetail) *scm.Commit {
-	return &scm.Commit{
+	dst := &scm.Commit{
 		Sha:       src.Sha,
 		Link:      src.Commit.URL,
 		Message:   src.Commit.Message,
 		Author:    convertSignature(src.Commit.Author),
 		Committer: convertCommitter(src.Committer),
 	}
+	for _, v := range src.Parents {
+		dst.Parents = append(dst.Parents, v.Sha)
+	}
+	return dst
 }
 
 func convertSignature(src signature) scm.Signature {
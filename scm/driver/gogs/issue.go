@@ -7,6 +7,8 @@ package gogs
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -56,13 +58,33 @@ func (s *issueService) FindComment(ctx context.Context, repo string, index, id i
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *issueService) List(ctx context.Context, repo string, _ scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
+func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
+	if len(opts.Labels) != 0 || opts.Assignee != "" || opts.Author != "" || opts.Mentioned != "" ||
+		opts.Milestone != 0 || opts.UpdatedAfter != nil || opts.Sort != "" {
+		return nil, nil, scm.ErrNotSupported
+	}
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
+	if params := encodeIssueListOptions(opts); params != "" {
+		path += "?" + params
+	}
 	out := []*issue{}
 	res, err := s.client.do(ctx, "GET", path, nil, &out)
 	return convertIssueList(out), res, err
 }
 
+// encodeIssueListOptions encodes the issue list options. Gogs
+// only supports filtering by state.
+func encodeIssueListOptions(opts scm.IssueListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Closed && !opts.Open {
+		params.Set("state", "closed")
+	}
+	return params.Encode()
+}
+
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, _ scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
 	out := []*issueComment{}
@@ -73,8 +95,20 @@ func (s *issueService) ListComments(ctx context.Context, repo string, index int,
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/repos/%s/issues", repo)
 	in := &issueInput{
-		Title: input.Title,
-		Body:  input.Body,
+		Title:     input.Title,
+		Body:      input.Body,
+		Milestone: int64(input.Milestone),
+	}
+	// gogs supports a single assignee only.
+	if len(input.Assignees) != 0 {
+		in.Assignee = input.Assignees[0]
+	}
+	for _, name := range input.Labels {
+		id, res, err := (&repositoryService{client: s.client}).lookupLabel(ctx, repo, name)
+		if err != nil {
+			return nil, res, err
+		}
+		in.Labels = append(in.Labels, id)
 	}
 	out := new(issue)
 	res, err := s.client.do(ctx, "POST", path, in, out)
@@ -131,26 +165,39 @@ func (s *issueService) ClearMilestone(ctx context.Context, repo string, id int)
 type (
 	// gogs issue response object.
 	issue struct {
-		ID          int       `json:"id"`
-		Number      int       `json:"number"`
-		User        user      `json:"user"`
-		Title       string    `json:"title"`
-		Body        string    `json:"body"`
-		State       string    `json:"state"`
-		Labels      []string  `json:"labels"`
-		Comments    int       `json:"comments"`
-		Created     time.Time `json:"created_at"`
-		Updated     time.Time `json:"updated_at"`
+		ID          int        `json:"id"`
+		Number      int        `json:"number"`
+		User        user       `json:"user"`
+		Title       string     `json:"title"`
+		Body        string     `json:"body"`
+		State       string     `json:"state"`
+		Labels      []*label   `json:"labels"`
+		Milestone   *milestone `json:"milestone"`
+		Comments    int        `json:"comments"`
+		Created     time.Time  `json:"created_at"`
+		Updated     time.Time  `json:"updated_at"`
 		PullRequest *struct {
 			Merged   bool        `json:"merged"`
 			MergedAt interface{} `json:"merged_at"`
 		} `json:"pull_request"`
 	}
 
+	// gogs milestone response object.
+	milestone struct {
+		ID          int        `json:"id"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		State       string     `json:"state"`
+		Deadline    *time.Time `json:"deadline"`
+	}
+
 	// gogs issue request object.
 	issueInput struct {
-		Title string `json:"title"`
-		Body  string `json:"body"`
+		Title     string  `json:"title"`
+		Body      string  `json:"body"`
+		Assignee  string  `json:"assignee,omitempty"`
+		Milestone int64   `json:"milestone,omitempty"`
+		Labels    []int64 `json:"labels,omitempty"`
 	}
 
 	// gogs issue comment response object.
@@ -183,14 +230,38 @@ func convertIssueList(from []*issue) []*scm.Issue {
 
 func convertIssue(from *issue) *scm.Issue {
 	return &scm.Issue{
-		Number:  from.Number,
-		Title:   from.Title,
-		Body:    from.Body,
-		Link:    "", // TODO construct the link to the issue.
-		Closed:  from.State == "closed",
-		Author:  *convertUser(&from.User),
-		Created: from.Created,
-		Updated: from.Updated,
+		Number:    from.Number,
+		Title:     from.Title,
+		Body:      from.Body,
+		Link:      "", // TODO construct the link to the issue.
+		Closed:    from.State == "closed",
+		Labels:    convertIssueLabels(from.Labels),
+		Author:    *convertUser(&from.User),
+		Milestone: convertMilestone(from.Milestone),
+		Created:   from.Created,
+		Updated:   from.Updated,
+	}
+}
+
+func convertIssueLabels(from []*label) []*scm.Label {
+	var to []*scm.Label
+	for _, v := range from {
+		to = append(to, convertLabel(v))
+	}
+	return to
+}
+
+func convertMilestone(from *milestone) *scm.Milestone {
+	if from == nil {
+		return nil
+	}
+	return &scm.Milestone{
+		Number:      from.ID,
+		ID:          from.ID,
+		Title:       from.Title,
+		Description: from.Description,
+		State:       from.State,
+		DueDate:     from.Deadline,
 	}
 }
 
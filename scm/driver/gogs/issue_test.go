@@ -69,6 +69,32 @@ func TestIssueList(t *testing.T) {
 	}
 }
 
+func TestIssueListClosed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/issues").
+		MatchParam("page", "2").
+		MatchParam("state", "closed").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.List(context.Background(), "gogits/gogs", scm.IssueListOptions{Page: 2, Closed: true})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIssueListFilters(t *testing.T) {
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.List(context.Background(), "gogits/gogs", scm.IssueListOptions{Labels: []string{"bug"}})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
 func TestIssueCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -99,6 +125,40 @@ func TestIssueCreate(t *testing.T) {
 	}
 }
 
+func TestIssueCreateWithLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/issues").
+		BodyString(`"assignee":"janedoe","milestone":2,"labels":\[1\]`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	input := scm.IssueInput{
+		Title:     "Bug found",
+		Body:      "I'm having a problem with this.",
+		Labels:    []string{"bug"},
+		Assignees: []string{"janedoe"},
+		Milestone: 2,
+	}
+
+	client, _ := New("https://try.gogs.io")
+	_, _, err := client.Issues.Create(context.Background(), "gogits/gogs", &input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestIssueClose(t *testing.T) {
 	client, _ := New("https://try.gogs.io")
 	_, err := client.Issues.Close(context.Background(), "gogits/go-gogs-client", 1)
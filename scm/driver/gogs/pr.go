@@ -6,6 +6,7 @@ package gogs
 
 import (
 	"context"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -30,6 +31,10 @@ func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) ListCommits(context.Context, string, int, scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -67,10 +72,30 @@ func (s *pullService) Merge(context.Context, string, int, *scm.PullRequestMergeO
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) EnableAutoMerge(context.Context, string, int, *scm.AutoMergeOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) DisableAutoMerge(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) SetDraft(context.Context, string, int, bool) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Update(ctx context.Context, repo string, number int, prInput *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+func (s *pullService) UpdatePullRequestBranch(context.Context, string, int, *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RebasePullRequest(context.Context, string, int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -99,6 +124,18 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return nil, scm.ErrNotSupported
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *pullService) SetMilestone(ctx context.Context, repo string, prID int, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -125,6 +162,9 @@ type pullRequest struct {
 	HTMLURL    string     `json:"html_url"`
 	Mergeable  bool       `json:"mergeable"`
 	Merged     bool       `json:"merged"`
+	MergedAt   *time.Time `json:"merged_at"`
+	MergeSha   string     `json:"merge_commit_sha"`
+	MergedBy   *user      `json:"merged_by"`
 }
 
 //
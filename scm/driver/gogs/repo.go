@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
@@ -45,8 +46,61 @@ func (s *repositoryService) ListCollaborators(ctx context.Context, repo string,
 	return nil, nil, scm.ErrNotSupported
 }
 
-func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *repositoryService) ListLabels(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	out := []*label{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertLabelList(out), res, err
+}
+
+func (s *repositoryService) CreateLabel(ctx context.Context, repo string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/labels", repo)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: labelColor(input.Color),
+	}
+	out := new(label)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) UpdateLabel(ctx context.Context, repo, name string, input *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	id, res, err := s.lookupLabel(ctx, repo, name)
+	if err != nil {
+		return nil, res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, id)
+	in := &labelInput{
+		Name:  input.Name,
+		Color: labelColor(input.Color),
+	}
+	out := new(label)
+	res, err = s.client.do(ctx, "PATCH", path, in, out)
+	return convertLabel(out), res, err
+}
+
+func (s *repositoryService) DeleteLabel(ctx context.Context, repo, name string) (*scm.Response, error) {
+	id, res, err := s.lookupLabel(ctx, repo, name)
+	if err != nil {
+		return res, err
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/labels/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// lookupLabel returns the identifier of the repository label with
+// the given name, or scm.ErrNotFound.
+func (s *repositoryService) lookupLabel(ctx context.Context, repo, name string) (int64, *scm.Response, error) {
+	labels, res, err := s.ListLabels(ctx, repo, scm.ListOptions{})
+	if err != nil {
+		return 0, res, err
+	}
+	for _, label := range labels {
+		if label.Name == name {
+			return label.ID, res, nil
+		}
+	}
+	return 0, res, scm.ErrNotFound
 }
 
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
@@ -135,6 +189,10 @@ func (s *repositoryService) Delete(context.Context, string) (*scm.Response, erro
 	return nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) SetDefaultBranch(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 //
 // native data structures
 //
@@ -164,6 +222,19 @@ type (
 		Pull  bool `json:"pull"`
 	}
 
+	// gogs label resource.
+	label struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+		URL   string `json:"url"`
+	}
+
+	labelInput struct {
+		Name  string `json:"name,omitempty"`
+		Color string `json:"color,omitempty"`
+	}
+
 	// gogs hook resource.
 	hook struct {
 		ID     int        `json:"id"`
@@ -215,6 +286,32 @@ func convertPerm(src perm) *scm.Perm {
 	}
 }
 
+func convertLabelList(src []*label) []*scm.Label {
+	dst := []*scm.Label{}
+	for _, v := range src {
+		dst = append(dst, convertLabel(v))
+	}
+	return dst
+}
+
+func convertLabel(src *label) *scm.Label {
+	return &scm.Label{
+		ID:    src.ID,
+		Name:  src.Name,
+		Color: src.Color,
+		URL:   src.URL,
+	}
+}
+
+// labelColor returns the hex color code with the leading # which
+// is required by Gogs.
+func labelColor(color string) string {
+	if color == "" || strings.HasPrefix(color, "#") {
+		return color
+	}
+	return "#" + color
+}
+
 func convertHookList(src []*hook) []*scm.Hook {
 	var dst []*scm.Hook
 	for _, v := range src {
@@ -281,3 +281,100 @@ func TestStatusCreate(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+//
+// label sub-tests
+//
+
+func TestRepoListLabels(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.ListLabels(context.Background(), "gogits/gogs", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Label{}
+	raw, _ := ioutil.ReadFile("testdata/labels.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepoCreateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Post("/api/v1/repos/gogits/gogs/labels").
+		BodyString(`{"name":"bug","color":"#ee0701"}`).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":1,"name":"bug","color":"#ee0701","url":"https://try.gogs.io/api/v1/repos/gogits/gogs/labels/1"}`)
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.CreateLabel(context.Background(), "gogits/gogs", &scm.LabelInput{Name: "bug", Color: "ee0701"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.ID != 1 || got.Name != "bug" {
+		t.Errorf("Unexpected label %+v", got)
+	}
+}
+
+func TestRepoUpdateLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Patch("/api/v1/repos/gogits/gogs/labels/2").
+		BodyString(`{"name":"feature"}`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":2,"name":"feature","color":"#84b6eb"}`)
+
+	client, _ := New("https://try.gogs.io")
+	got, _, err := client.Repositories.UpdateLabel(context.Background(), "gogits/gogs", "enhancement", &scm.LabelInput{Name: "feature"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Name != "feature" {
+		t.Errorf("Want label name feature, got %s", got.Name)
+	}
+}
+
+func TestRepoDeleteLabel(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gogs.io").
+		Get("/api/v1/repos/gogits/gogs/labels").
+		Reply(200).
+		Type("application/json").
+		File("testdata/labels.json")
+
+	gock.New("https://try.gogs.io").
+		Delete("/api/v1/repos/gogits/gogs/labels/1").
+		Reply(204)
+
+	client, _ := New("https://try.gogs.io")
+	if _, err := client.Repositories.DeleteLabel(context.Background(), "gogits/gogs", "bug"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,17 @@
+package gogs
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+// Find returns the server details. Gogs does not expose its version
+// through the API so only the product is reported.
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	return &scm.ServerInfo{Product: scm.DriverGogs.String(), Edition: scm.EditionCommunity}, nil, nil
+}
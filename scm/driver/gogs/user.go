@@ -36,6 +36,15 @@ func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindToken(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	// gogs access tokens have no scopes or expiry.
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.TokenInfo{User: user}, res, nil
+}
+
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
 	user, res, err := s.Find(ctx)
 	return user.Email, res, err
@@ -246,7 +246,7 @@ func convertPushHook(dst *pushHook) *scm.PushHook {
 }
 
 func convertPullRequestHook(dst *pullRequestHook) *scm.PullRequestHook {
-	return &scm.PullRequestHook{
+	hook := &scm.PullRequestHook{
 		Action: convertAction(dst.Action),
 		PullRequest: scm.PullRequest{
 			Number: dst.PullRequest.Number,
@@ -267,10 +267,17 @@ func convertPullRequestHook(dst *pullRequestHook) *scm.PullRequestHook {
 			Fork:   dst.PullRequest.HeadRepo.FullName,
 			Ref:    fmt.Sprintf("refs/pull/%d/head", dst.PullRequest.Number),
 			// Sha:    "",
+			MergeSha: dst.PullRequest.MergeSha,
+			MergedAt: dst.PullRequest.MergedAt,
+			FromFork: dst.PullRequest.HeadRepo.ID != dst.PullRequest.BaseRepo.ID,
 		},
 		Repo:   *convertRepository(&dst.Repository),
 		Sender: *convertUser(&dst.Sender),
 	}
+	if dst.PullRequest.MergedBy != nil {
+		hook.PullRequest.MergedBy = convertUser(dst.PullRequest.MergedBy)
+	}
+	return hook
 }
 
 func convertPullRequestCommentHook(dst *issueHook) *scm.PullRequestCommentHook {
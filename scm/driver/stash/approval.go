@@ -0,0 +1,173 @@
+package stash
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type approvalService struct {
+	client *wrapper
+}
+
+// ListRules returns the default reviewer conditions matching the
+// source and target branches of the pull request.
+//
+// See https://docs.atlassian.com/bitbucket-server/rest/latest/bitbucket-default-reviewers-rest.html
+func (s *approvalService) ListRules(ctx context.Context, repo string, number int) ([]*scm.ApprovalRule, *scm.Response, error) {
+	pr, res, err := s.findPullRequest(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.listRules(ctx, repo, pr)
+}
+
+// FindStatus evaluates the default reviewer conditions against the
+// reviewers who approved the pull request.
+func (s *approvalService) FindStatus(ctx context.Context, repo string, number int) (*scm.ApprovalStatus, *scm.Response, error) {
+	pr, res, err := s.findPullRequest(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	rules, res, err := s.listRules(ctx, repo, pr)
+	if err != nil {
+		return nil, res, err
+	}
+	approvedBy := []scm.User{}
+	for _, reviewer := range pr.Reviewers {
+		if reviewer.Approved {
+			approvedBy = append(approvedBy, *convertUser(&reviewer.User))
+		}
+	}
+	return scm.EvaluateApprovals(rules, approvedBy), res, nil
+}
+
+func (s *approvalService) Approve(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/approve", namespace, name, number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *approvalService) Unapprove(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/approve", namespace, name, number)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+func (s *approvalService) findPullRequest(ctx context.Context, repo string, number int) (*pullRequest, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return out, res, err
+}
+
+func (s *approvalService) listRules(ctx context.Context, repo string, pr *pullRequest) ([]*scm.ApprovalRule, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/default-reviewers/1.0/projects/%s/repos/%s/conditions", namespace, name)
+	out := []*reviewerCondition{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	rules := []*scm.ApprovalRule{}
+	for _, v := range out {
+		if v.SourceRefMatcher.matches(&pr.FromRef) && v.TargetRefMatcher.matches(&pr.ToRef) {
+			rules = append(rules, convertReviewerCondition(v))
+		}
+	}
+	return rules, res, nil
+}
+
+type reviewerCondition struct {
+	ID                int        `json:"id"`
+	RequiredApprovals int        `json:"requiredApprovals"`
+	Reviewers         []*user    `json:"reviewers"`
+	SourceRefMatcher  refMatcher `json:"sourceRefMatcher"`
+	TargetRefMatcher  refMatcher `json:"targetRefMatcher"`
+}
+
+type refMatcher struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId"`
+	Type      struct {
+		ID string `json:"id"`
+	} `json:"type"`
+}
+
+// matches returns true if the matcher matches the ref. Branching
+// model matchers, and patterns which cannot be evaluated, always
+// match, so that the condition is not ignored.
+func (m *refMatcher) matches(ref *prRepoRef) bool {
+	switch m.Type.ID {
+	case "BRANCH":
+		return m.ID == ref.ID
+	case "PATTERN":
+		ok, err := matchPattern(m.ID, ref.DisplayID)
+		if err != nil {
+			return true
+		}
+		if !ok {
+			ok, err = matchPattern(m.ID, ref.ID)
+		}
+		return ok || err != nil
+	default:
+		return true
+	}
+}
+
+// matchPattern returns true if the name matches the Ant-style
+// pattern, where ** matches any number of path segments, * any
+// characters within a segment and ? a single character. A pattern
+// ending with a slash matches everything below it.
+func matchPattern(pattern, name string) (bool, error) {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	segments := strings.Split(pattern, "/")
+	for _, segment := range segments {
+		if _, err := path.Match(segment, ""); err != nil {
+			return false, err
+		}
+	}
+	return matchSegments(segments, strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) (bool, error) {
+	for len(pattern) != 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				ok, err := matchSegments(pattern[1:], name[i:])
+				if ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if !ok || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
+
+func convertReviewerCondition(from *reviewerCondition) *scm.ApprovalRule {
+	to := &scm.ApprovalRule{
+		ID:        from.ID,
+		Name:      fmt.Sprintf("%s to %s", from.SourceRefMatcher.DisplayID, from.TargetRefMatcher.DisplayID),
+		Required:  from.RequiredApprovals,
+		Approvers: []scm.User{},
+	}
+	for _, v := range from.Reviewers {
+		to.Approvers = append(to.Approvers, *convertUser(v))
+	}
+	return to
+}
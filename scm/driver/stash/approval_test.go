@@ -0,0 +1,137 @@
+package stash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestApprovalListRules(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Get("rest/default-reviewers/1.0/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/default_reviewers.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Approvals.ListRules(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, 3, got[0].ID)
+	assert.Equal(t, "ANY_REF_MATCHER_ID to master", got[0].Name)
+	assert.Equal(t, 1, got[0].Required)
+	assert.Equal(t, "tom", got[0].Approvers[0].Login)
+	assert.Equal(t, 4, got[1].ID)
+	assert.Equal(t, "jane", got[1].Approvers[0].Login)
+}
+
+func TestApprovalFindStatus(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Get("rest/default-reviewers/1.0/projects/PRJ/repos/my-repo/conditions").
+		Reply(200).
+		Type("application/json").
+		File("testdata/default_reviewers.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Approvals.FindStatus(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.False(t, got.Approved)
+	assert.Len(t, got.ApprovedBy, 1)
+	assert.Equal(t, "tom", got.ApprovedBy[0].Login)
+	assert.True(t, got.Rules[0].Approved)
+	assert.Len(t, got.Missing, 1)
+	assert.Equal(t, 4, got.Missing[0].ID)
+}
+
+func TestApprovalApprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/approve").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Approvals.Approve(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApprovalUnapprove(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/approve").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Approvals.Unapprove(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"release/**", "release/1.0", true},
+		{"release/**", "release/1.0/hotfix", true},
+		{"release/**", "release", true},
+		{"release/**", "feature/1.0", false},
+		{"**/hotfix", "hotfix", true},
+		{"**/hotfix", "release/1.0/hotfix", true},
+		{"**/hotfix", "release/hotfixes", false},
+		{"release/", "release/1.0/hotfix", true},
+		{"release/*", "release/1.0", true},
+		{"release/*", "release/1.0/hotfix", false},
+		{"*", "feature/x", false},
+		{"release-?", "release-1", true},
+		{"release-?", "release-10", false},
+		{"master", "master", true},
+	}
+	for _, test := range tests {
+		got, err := matchPattern(test.pattern, test.name)
+		if err != nil {
+			t.Errorf("Unexpected error matching %q against %q: %v", test.name, test.pattern, err)
+		}
+		if got != test.want {
+			t.Errorf("Want %q matching %q to be %v", test.name, test.pattern, test.want)
+		}
+	}
+}
+
+func TestRefMatcherInvalidPattern(t *testing.T) {
+	m := &refMatcher{ID: "release/[", DisplayID: "release/["}
+	m.Type.ID = "PATTERN"
+	if !m.matches(&prRepoRef{ID: "refs/heads/master", DisplayID: "master"}) {
+		t.Errorf("Want a pattern which cannot be evaluated to match")
+	}
+}
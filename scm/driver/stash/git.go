@@ -15,7 +15,6 @@ import (
 )
 
 // TODO(bradrydzewski) commit link is an empty string.
-// TODO(bradrydzewski) commit list is not supported; behavior incompatible with other drivers.
 
 type gitService struct {
 	client *wrapper
@@ -44,6 +43,27 @@ func (s *gitService) CreateRef(ctx context.Context, repo, ref, sha string) (*scm
 	return nil, nil, scm.ErrNotSupported
 }
 
+// CreateTag creates an annotated tag. A tag without a message would
+// be created as a lightweight tag, so the message is required.
+func (s *gitService) CreateTag(ctx context.Context, repo string, input *scm.TagInput) (*scm.Tag, *scm.Response, error) {
+	if input.Message == "" {
+		return nil, nil, scm.ErrTagMessageRequired
+	}
+	if input.Tagger != nil {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags", namespace, name)
+	in := &tagInput{
+		Name:       input.Name,
+		StartPoint: input.Target,
+		Message:    input.Message,
+	}
+	out := new(tag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertAnnotatedTag(out), res, err
+}
+
 func (s *gitService) DeleteRef(ctx context.Context, repo, ref string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -64,6 +84,22 @@ func (s *gitService) FindBranch(ctx context.Context, repo, branch string) (*scm.
 	return nil, res, scm.ErrNotFound
 }
 
+func (s *gitService) CreateBranch(ctx context.Context, repo string, input *scm.BranchInput) (*scm.Reference, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches", namespace, name)
+	in := &branchInput{
+		Name:       input.Name,
+		StartPoint: input.Ref,
+	}
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertBranch(out), res, err
+}
+
+func (s *gitService) RenameBranch(ctx context.Context, repo, branchName, name string) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits/%s", namespace, name, url.PathEscape(ref))
@@ -88,6 +124,16 @@ func (s *gitService) FindTag(ctx context.Context, repo, tag string) (*scm.Refere
 	return nil, res, scm.ErrNotFound
 }
 
+// FindTagObject finds a tag by name. The api does not return the
+// message or tagger of annotated tags.
+func (s *gitService) FindTagObject(ctx context.Context, repo, tagName string) (*scm.Tag, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags/%s", namespace, name, url.PathEscape(tagName))
+	out := new(tag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertAnnotatedTag(out), res, err
+}
+
 func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches?%s", namespace, name, encodeListOptions(opts))
@@ -101,7 +147,22 @@ func (s *gitService) ListBranches(ctx context.Context, repo string, opts scm.Lis
 }
 
 func (s *gitService) ListCommits(ctx context.Context, repo string, opts scm.CommitListOptions) ([]*scm.Commit, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the api can only filter commits by path.
+	if opts.Author != "" || !opts.Since.IsZero() || !opts.Until.IsZero() || opts.FirstParent {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits?%s", namespace, name, encodeCommitListOptions(opts))
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertCommitList(out), res, err
 }
 
 func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -142,6 +203,38 @@ func (s *gitService) CompareCommits(ctx context.Context, repo, ref1, ref2 string
 	return convertDiffstats(out), res, err
 }
 
+func (s *gitService) UpdateRef(ctx context.Context, repo, ref string, input *scm.RefInput) (*scm.Reference, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindBlob(ctx context.Context, repo, sha string) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateBlob(ctx context.Context, repo string, content []byte) (*scm.Blob, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) FindTree(ctx context.Context, repo, sha string, recursive bool) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateTree(ctx context.Context, repo string, input *scm.TreeInput) (*scm.Tree, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CreateCommit(ctx context.Context, repo string, input *scm.CommitInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) CommitFiles(ctx context.Context, repo string, input *scm.CommitFilesInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *gitService) MergeBranch(ctx context.Context, repo string, input *scm.MergeInput) (*scm.Commit, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 type branch struct {
 	ID              string `json:"id"`
 	DisplayID       string `json:"displayId"`
@@ -151,11 +244,34 @@ type branch struct {
 	IsDefault       bool   `json:"isDefault"`
 }
 
+type tag struct {
+	ID           string `json:"id"`
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+	Hash         string `json:"hash"`
+}
+
+type branchInput struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+}
+
+type tagInput struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
 type branches struct {
 	pagination
 	Values []*branch `json:"values"`
 }
 
+type commits struct {
+	pagination
+	Values []*commit `json:"values"`
+}
+
 type diffstats struct {
 	pagination
 	Values []*diffstat
@@ -264,8 +380,16 @@ func convertDiffstat(from *diffstat) *scm.Change {
 	return to
 }
 
+func convertCommitList(from *commits) []*scm.Commit {
+	to := []*scm.Commit{}
+	for _, v := range from.Values {
+		to = append(to, convertCommit(v))
+	}
+	return to
+}
+
 func convertCommit(from *commit) *scm.Commit {
-	return &scm.Commit{
+	to := &scm.Commit{
 		Message: from.Message,
 		Sha:     from.ID,
 		// Link:    "%s/projects/%s/repos/%s/commits/%s",
@@ -284,6 +408,10 @@ func convertCommit(from *commit) *scm.Commit {
 			Avatar: avatarLink(from.Committer.EmailAddress),
 		},
 	}
+	for _, parent := range from.Parents {
+		to.Parents = append(to.Parents, parent.ID)
+	}
+	return to
 }
 
 func convertBranchList(from *branches) []*scm.Reference {
@@ -310,6 +438,19 @@ func convertTagList(from *branches) []*scm.Reference {
 	return to
 }
 
+func convertAnnotatedTag(from *tag) *scm.Tag {
+	to := &scm.Tag{
+		Name:       from.DisplayID,
+		Target:     from.LatestCommit,
+		TargetType: "commit",
+	}
+	// a lightweight tag has no hash of its own.
+	if from.Hash != from.LatestCommit {
+		to.Sha = from.Hash
+	}
+	return to
+}
+
 func convertTag(from *branch) *scm.Reference {
 	return &scm.Reference{
 		Name: scm.TrimRef(from.DisplayID),
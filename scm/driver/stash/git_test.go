@@ -94,8 +94,41 @@ func TestGitFindTag(t *testing.T) {
 }
 
 func TestGitListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/commits").
+		MatchParam("until", "master").
+		MatchParam("path", "README.md").
+		MatchParam("limit", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.Git.ListCommits(context.Background(), "PRJ/my-repo", scm.CommitListOptions{Ref: "master", Path: "README.md", Page: 1, Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestGitListCommitsNotSupported(t *testing.T) {
 	client, _ := New("http://example.com:7990")
-	_, _, err := client.Git.ListCommits(context.Background(), "PRJ/my-repo", scm.CommitListOptions{Ref: "master", Page: 1, Size: 30})
+	_, _, err := client.Git.ListCommits(context.Background(), "PRJ/my-repo", scm.CommitListOptions{Ref: "master", Author: "jcitizen"})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
@@ -157,6 +190,111 @@ func TestGitListTags(t *testing.T) {
 	// t.Run("Page", testPage(res))
 }
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/branches").
+		JSON(map[string]string{
+			"name":       "feature",
+			"startPoint": "master",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch_create.json")
+
+	client, _ := New("http://example.com:7990")
+	input := &scm.BranchInput{Name: "feature", Ref: "master"}
+	got, _, err := client.Git.CreateBranch(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reference)
+	raw, _ := ioutil.ReadFile("testdata/branch_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]string{
+			"name":       "v1.0.0",
+			"startPoint": "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+			"message":    "Release v1.0.0",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_create.json")
+
+	client, _ := New("http://example.com:7990")
+	input := &scm.TagInput{
+		Name:    "v1.0.0",
+		Message: "Release v1.0.0",
+		Target:  "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+	got, _, err := client.Git.CreateTag(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCreateTagWithoutMessage(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	input := &scm.TagInput{
+		Name:   "v1.0.0",
+		Target: "131cb13f4aed12e725177bc4b7c28db67839bf9f",
+	}
+	_, _, err := client.Git.CreateTag(context.Background(), "PRJ/my-repo", input)
+	if err != scm.ErrTagMessageRequired {
+		t.Errorf("Want ErrTagMessageRequired, got %v", err)
+	}
+}
+
+func TestGitFindTagObject(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag_create.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Git.FindTagObject(context.Background(), "PRJ/my-repo", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Tag)
+	raw, _ := ioutil.ReadFile("testdata/tag_create.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
 func TestGitListChanges(t *testing.T) {
 	defer gock.Off()
 
@@ -49,6 +49,21 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), res, err
 }
 
+func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/commits?%s", namespace, name, number, encodeListOptions(opts))
+	out := new(commits)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertCommitList(out), res, err
+}
+
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/changes", namespace, name, number)
@@ -118,11 +133,42 @@ func (s *pullService) Merge(ctx context.Context, repo string, number int, option
 	return res, err
 }
 
+// EnableAutoMerge requests the auto-merge of the pull request, which
+// is available since Bitbucket Data Center 8.15. The pull request is
+// merged with the merge strategy of the repository, so the options
+// are not supported.
+func (s *pullService) EnableAutoMerge(ctx context.Context, repo string, number int, options *scm.AutoMergeOptions) (*scm.Response, error) {
+	if options != nil && *options != (scm.AutoMergeOptions{}) {
+		return nil, scm.ErrNotSupported
+	}
+	if s.client.serverOlderThan(ctx, "8.15") {
+		return nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/latest/projects/%s/repos/%s/pull-requests/%d/auto-merge", namespace, name, number)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// DisableAutoMerge cancels the auto-merge of the pull request.
+func (s *pullService) DisableAutoMerge(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/latest/projects/%s/repos/%s/pull-requests/%d/auto-merge", namespace, name, number)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
 type prUpdateInput struct {
-	ID          int    `json:"id"`
-	Version     int    `json:"version"`
-	Title       string `json:"title,omitempty"`
-	Description string `json:"description,omitempty"`
+	ID          int                `json:"id"`
+	Version     int                `json:"version"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Draft       *bool              `json:"draft,omitempty"`
+	Reviewers   []*prReviewerInput `json:"reviewers,omitempty"`
+}
+
+type prReviewerInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
 }
 
 func (s *pullService) Update(ctx context.Context, repo string, number int, prInput *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
@@ -134,18 +180,61 @@ func (s *pullService) Update(ctx context.Context, repo string, number int, prInp
 	if err != nil {
 		return nil, res, err
 	}
+	if len(prInput.Labels) != 0 || len(prInput.Assignees) != 0 || prInput.Milestone != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
 	input := &prUpdateInput{
 		ID:          getOut.ID,
 		Version:     getOut.Version,
 		Title:       prInput.Title,
 		Description: prInput.Body,
 	}
+	// the reviewers replace the existing ones, so keep those
+	// which are already reviewing the pull request.
+	if len(prInput.Reviewers) != 0 {
+		logins := []string{}
+		for _, r := range getOut.Reviewers {
+			logins = append(logins, r.User.Name)
+		}
+		input.Reviewers = convertReviewerInputs(append(logins, prInput.Reviewers...))
+	}
 	out := new(pullRequest)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
 	res, err = s.client.do(ctx, "PUT", path, input, out)
 	return convertPullRequest(out), res, err
 }
 
+// SetDraft converts the pull request to a draft, or publishes it,
+// which is available since Bitbucket Data Center 8.18.
+func (s *pullService) SetDraft(ctx context.Context, repo string, number int, draft bool) (*scm.Response, error) {
+	if s.client.serverOlderThan(ctx, "8.18") {
+		return nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	input := &prUpdateInput{
+		ID:          out.ID,
+		Version:     out.Version,
+		Title:       out.Title,
+		Description: out.Description,
+		Draft:       &draft,
+	}
+	return s.client.do(ctx, "PUT", path, input, nil)
+}
+
+func (s *pullService) UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *scm.PullRequestUpdateBranchOptions) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) RebasePullRequest(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/decline", namespace, name, number)
@@ -214,9 +303,22 @@ func (s *pullService) UnassignIssue(ctx context.Context, repo string, number int
 }
 
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
+	if len(input.Labels) != 0 || len(input.Assignees) != 0 || input.Milestone != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	// drafts are available since Bitbucket Data Center 8.18.
+	if input.Draft && s.client.serverOlderThan(ctx, "8.18") {
+		return nil, nil, scm.ErrNotSupported
+	}
 	namespace, name := scm.Split(repo)
 	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests", namespace, name)
 
+	// the head of a fork is given as project:branch, where the
+	// project key of a personal fork is ~user.
+	fromProject, fromBranch := scm.SplitHead(input.Head)
+	if fromProject == "" {
+		fromProject = namespace
+	}
 	in := &createPRInput{
 		Title:       input.Title,
 		Description: input.Body,
@@ -224,10 +326,10 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 		Open:        true,
 		Closed:      false,
 		FromRef: createPRInputRef{
-			ID: fmt.Sprintf("refs/heads/%s", input.Head),
+			ID: fmt.Sprintf("refs/heads/%s", fromBranch),
 			Repository: createPRInputRepo{
 				Slug:    name,
-				Project: createPRInputRepoProject{Key: namespace},
+				Project: createPRInputRepoProject{Key: fromProject},
 			},
 		},
 		ToRef: createPRInputRef{
@@ -237,7 +339,9 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 				Project: createPRInputRepoProject{Key: namespace},
 			},
 		},
-		Locked: false,
+		Locked:    false,
+		Draft:     input.Draft,
+		Reviewers: convertReviewerInputs(input.Reviewers),
 	}
 
 	out := new(pullRequest)
@@ -254,18 +358,29 @@ func (s *pullService) RequestReview(ctx context.Context, repo string, number int
 	var res *scm.Response
 	var err error
 
+	// the per-user participants endpoint is only available from
+	// Bitbucket Server 4.2, older servers assign the reviewer role.
+	legacy := s.client.serverOlderThan(ctx, "4.2")
+
 	for _, l := range logins {
-		input := pullRequestAssignInput{
-			User: struct {
-				Name string `json:"name"`
-			}{
-				Name: l,
-			},
-			Approved: false,
-			Status:   "UNAPPROVED",
+		if legacy {
+			input := pullRequestParticipantInput{Role: "REVIEWER"}
+			input.User.Name = l
+			path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants", namespace, name, number)
+			res, err = s.client.do(ctx, "POST", path, &input, nil)
+		} else {
+			input := pullRequestAssignInput{
+				User: struct {
+					Name string `json:"name"`
+				}{
+					Name: l,
+				},
+				Approved: false,
+				Status:   "UNAPPROVED",
+			}
+			path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, url.PathEscape(l))
+			res, err = s.client.do(ctx, "PUT", path, &input, nil)
 		}
-		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/participants/%s", namespace, name, number, url.PathEscape(l))
-		res, err = s.client.do(ctx, "PUT", path, &input, nil)
 		if err != nil && res != nil {
 			missing.Users = append(missing.Users, l)
 		} else if err != nil {
@@ -293,6 +408,25 @@ func (s *pullService) UnrequestReview(ctx context.Context, repo string, number i
 	return res, err
 }
 
+func (s *pullService) RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *pullService) UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// ListReviewers returns the reviewers of the pull request with their
+// approval status. Bitbucket Server does not report when the review
+// happened.
+func (s *pullService) ListReviewers(ctx context.Context, repo string, number int) ([]*scm.Reviewer, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", namespace, name, number)
+	out := new(pullRequest)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertReviewerList(out.Reviewers), res, err
+}
+
 func (s *pullService) SetMilestone(ctx context.Context, repo string, prID int, number int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
@@ -302,14 +436,16 @@ func (s *pullService) ClearMilestone(ctx context.Context, repo string, prID int)
 }
 
 type createPRInput struct {
-	Title       string           `json:"title,omitempty"`
-	Description string           `json:"description,omitempty"`
-	State       string           `json:"state,omitempty"`
-	Open        bool             `json:"open,omitempty"`
-	Closed      bool             `json:"closed,omitempty"`
-	FromRef     createPRInputRef `json:"fromRef,omitempty"`
-	ToRef       createPRInputRef `json:"toRef,omitempty"`
-	Locked      bool             `json:"locked,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	State       string             `json:"state,omitempty"`
+	Open        bool               `json:"open,omitempty"`
+	Closed      bool               `json:"closed,omitempty"`
+	FromRef     createPRInputRef   `json:"fromRef,omitempty"`
+	ToRef       createPRInputRef   `json:"toRef,omitempty"`
+	Locked      bool               `json:"locked,omitempty"`
+	Draft       bool               `json:"draft,omitempty"`
+	Reviewers   []*prReviewerInput `json:"reviewers,omitempty"`
 }
 
 type createPRInputRef struct {
@@ -339,10 +475,17 @@ type pullRequest struct {
 	FromRef      prRepoRef     `json:"fromRef"`
 	ToRef        prRepoRef     `json:"toRef"`
 	Locked       bool          `json:"locked"`
+	Draft        bool          `json:"draft"`
 	Author       prUser        `json:"author"`
 	Reviewers    []prUser      `json:"reviewers"`
 	Participants []interface{} `json:"participants"`
-	Links        struct {
+	ClosedDate   int64         `json:"closedDate"`
+	Properties   struct {
+		MergeCommit struct {
+			ID string `json:"id"`
+		} `json:"mergeCommit"`
+	} `json:"properties"`
+	Links struct {
 		Self []link `json:"self"`
 	} `json:"links"`
 }
@@ -382,7 +525,7 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 	)
 	toRepo := convertRepository(&from.ToRef.Repository)
 	fromRepo := convertRepository(&from.FromRef.Repository)
-	return &scm.PullRequest{
+	to := &scm.PullRequest{
 		Number: from.ID,
 		Title:  from.Title,
 		Body:   from.Description,
@@ -405,6 +548,7 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 		State:     strings.ToLower(from.State),
 		Closed:    from.Closed,
 		Merged:    from.State == "MERGED",
+		Draft:     from.Draft,
 		Reviewers: convertReviewers(from.Reviewers),
 		Created:   time.Unix(from.CreatedDate/1000, 0),
 		Updated:   time.Unix(from.UpdatedDate/1000, 0),
@@ -414,7 +558,39 @@ func convertPullRequest(from *pullRequest) *scm.PullRequest {
 			Email:  from.Author.User.EmailAddress,
 			Avatar: avatarLink(from.Author.User.EmailAddress),
 		},
+		MergeSha:       from.Properties.MergeCommit.ID,
+		FromFork:       from.FromRef.Repository.ID != from.ToRef.Repository.ID,
+		ReviewDecision: convertReviewDecision(from.Reviewers),
+	}
+	if from.ClosedDate != 0 {
+		closed := time.Unix(from.ClosedDate/1000, 0)
+		to.ClosedAt = &closed
+		if to.Merged {
+			to.MergedAt = &closed
+		}
 	}
+	return to
+}
+
+// convertReviewDecision derives the review decision from the
+// status of the reviewers.
+func convertReviewDecision(reviewers []prUser) scm.ReviewDecision {
+	if len(reviewers) == 0 {
+		return scm.ReviewDecisionUnknown
+	}
+	approved := false
+	for _, r := range reviewers {
+		switch r.Status {
+		case "NEEDS_WORK":
+			return scm.ReviewDecisionChangesRequested
+		case "APPROVED":
+			approved = true
+		}
+	}
+	if approved {
+		return scm.ReviewDecisionApproved
+	}
+	return scm.ReviewDecisionReviewRequired
 }
 
 type pullRequestComment struct {
@@ -448,6 +624,13 @@ type pullRequestAssignInput struct {
 	Status   string `json:"status"`
 }
 
+type pullRequestParticipantInput struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Role string `json:"role"`
+}
+
 type pullRequestActivities struct {
 	pagination
 	Values []*pullRequestActivity `json:"values"`
@@ -490,6 +673,46 @@ func convertPullRequestComment(from *pullRequestComment) *scm.Comment {
 	}
 }
 
+func convertReviewerInputs(logins []string) []*prReviewerInput {
+	var to []*prReviewerInput
+	seen := map[string]bool{}
+	for _, login := range logins {
+		if seen[login] {
+			continue
+		}
+		seen[login] = true
+		r := new(prReviewerInput)
+		r.User.Name = login
+		to = append(to, r)
+	}
+	return to
+}
+
+func convertReviewerList(from []prUser) []*scm.Reviewer {
+	to := []*scm.Reviewer{}
+	for _, u := range from {
+		to = append(to, &scm.Reviewer{
+			Kind:  scm.ReviewerKindUser,
+			User:  *convertUser(&u.User),
+			State: convertReviewerState(u.Status),
+		})
+	}
+	return to
+}
+
+// convertReviewerState converts the status of a pull request
+// reviewer to a review state.
+func convertReviewerState(from string) string {
+	switch from {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "NEEDS_WORK":
+		return scm.ReviewStateChangesRequested
+	default:
+		return scm.ReviewStatePending
+	}
+}
+
 func convertReviewers(from []prUser) []scm.User {
 	var answer []scm.User
 
@@ -79,6 +79,106 @@ func TestPullUpdate(t *testing.T) {
 	}
 }
 
+func TestPullUpdateReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		JSON(map[string]interface{}{
+			"id":      1,
+			"version": 0,
+			"reviewers": []map[string]interface{}{
+				{"user": map[string]string{"name": "tom"}},
+				{"user": map[string]string{"name": "jcitizen"}},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	input := &scm.PullRequestInput{
+		Reviewers: []string{"tom", "jcitizen"},
+	}
+	_, _, err := client.PullRequests.Update(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullListReviewers(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.PullRequests.ListReviewers(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*scm.Reviewer{}
+	raw, _ := ioutil.ReadFile("testdata/pr_reviewers.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestTeamReview(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.RequestTeamReview(context.Background(), "PRJ/my-repo", 1, []string{"devs"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullListCommits(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/commits").
+		MatchParam("limit", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commits.json")
+
+	client, _ := New("http://example.com:7990")
+	got, res, err := client.PullRequests.ListCommits(context.Background(), "PRJ/my-repo", 1, scm.ListOptions{Page: 1, Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Commit{}
+	raw, _ := ioutil.ReadFile("testdata/commits.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
 func TestPullFindComment(t *testing.T) {
 	defer gock.Off()
 
@@ -225,6 +325,60 @@ func TestPullMerge(t *testing.T) {
 	}
 }
 
+func TestPullEnableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/latest/projects/PRJ/repos/my-repo/pull-requests/1/auto-merge").
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.15.0"})
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "PRJ/my-repo", 1, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullEnableAutoMerge_Options(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.15.0"})
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "PRJ/my-repo", 1, &scm.AutoMergeOptions{MergeMethod: "squash"})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullEnableAutoMerge_LegacyServer(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.9.0"})
+	_, err := client.PullRequests.EnableAutoMerge(context.Background(), "PRJ/my-repo", 1, nil)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error, got %v", err)
+	}
+}
+
+func TestPullDisableAutoMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/api/latest/projects/PRJ/repos/my-repo/pull-requests/1/auto-merge").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.PullRequests.DisableAutoMerge(context.Background(), "PRJ/my-repo", 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
 func TestPullClose(t *testing.T) {
 	defer gock.Off()
 
@@ -350,6 +504,126 @@ func TestPullCreate(t *testing.T) {
 	}
 }
 
+func TestPullCreateFromFork(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests").
+		BodyString(`"fromRef":\{"id":"refs/heads/feature/x","repository":\{"slug":"my-repo","project":\{"key":"~JDOE"\}\}\}.*"reviewers":\[\{"user":\{"name":"tom"\}\}\]`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+
+	input := &scm.PullRequestInput{
+		Title:     "Updated Files",
+		Base:      "master",
+		Head:      "~JDOE:feature/x",
+		Reviewers: []string{"tom"},
+	}
+	_, _, err := client.PullRequests.Create(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullCreateLabels(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	input := &scm.PullRequestInput{
+		Title:  "Updated Files",
+		Base:   "master",
+		Head:   "feature/x",
+		Labels: []string{"bug"},
+	}
+	_, _, err := client.PullRequests.Create(context.Background(), "PRJ/my-repo", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestPullCreateDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests").
+		BodyString(`"draft":true`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.18.0"})
+
+	input := &scm.PullRequestInput{
+		Title: "Updated Files",
+		Base:  "master",
+		Head:  "feature/x",
+		Draft: true,
+	}
+	_, _, err := client.PullRequests.Create(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullSetDraft(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1").
+		JSON(map[string]interface{}{
+			"id":          1,
+			"version":     0,
+			"title":       "Updated Files",
+			"description": "* added LICENSE\r\n* update files\r\n* update files",
+			"draft":       false,
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.18.0"})
+	_, err := client.PullRequests.SetDraft(context.Background(), "PRJ/my-repo", 1, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
+
+func TestPullDraft_LegacyServer(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "8.17.0"})
+
+	input := &scm.PullRequestInput{
+		Title: "Updated Files",
+		Base:  "master",
+		Head:  "feature/x",
+		Draft: true,
+	}
+	if _, _, err := client.PullRequests.Create(context.Background(), "PRJ/my-repo", input); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error creating a draft, got %v", err)
+	}
+	if _, err := client.PullRequests.SetDraft(context.Background(), "PRJ/my-repo", 1, true); err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error setting a draft, got %v", err)
+	}
+}
+
 func TestPullAddLabel(t *testing.T) {
 	defer gock.Off()
 
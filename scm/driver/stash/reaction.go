@@ -0,0 +1,110 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// reactionService implements the reactions to the pull request
+// comments, which are the only reactions supported by Bitbucket
+// Server.
+type reactionService struct {
+	client *wrapper
+}
+
+func (s *reactionService) ListIssueReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreateIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeleteIssueReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListPullRequestReactions(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji scm.Emoji) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+func (s *reactionService) ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts scm.ListOptions) ([]*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/comment-likes/latest/projects/%s/repos/%s/pull-requests/%d/comments/%d/reactions?%s", namespace, name, number, id, encodeListOptions(opts))
+	out := new(reactions)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && !out.pagination.LastPage.Bool {
+		res.Page.First = 1
+		res.Page.Next = opts.Page + 1
+	}
+	return convertReactionList(out), res, err
+}
+
+func (s *reactionService) CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Reaction, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/comment-likes/latest/projects/%s/repos/%s/pull-requests/%d/comments/%d/reactions/%s", namespace, name, number, id, emoji.Shortcode())
+	out := new(reaction)
+	res, err := s.client.do(ctx, "PUT", path, nil, out)
+	return convertReaction(out), res, err
+}
+
+func (s *reactionService) DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji scm.Emoji) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/comment-likes/latest/projects/%s/repos/%s/pull-requests/%d/comments/%d/reactions/%s", namespace, name, number, id, emoji.Shortcode())
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+type reaction struct {
+	Emoticon struct {
+		Shortcut string `json:"shortcut"`
+		URL      string `json:"url"`
+	} `json:"emoticon"`
+	User user `json:"user"`
+}
+
+type reactions struct {
+	pagination
+	Values []*reaction `json:"values"`
+}
+
+func convertReactionList(from *reactions) []*scm.Reaction {
+	to := []*scm.Reaction{}
+	for _, v := range from.Values {
+		to = append(to, convertReaction(v))
+	}
+	return to
+}
+
+func convertReaction(from *reaction) *scm.Reaction {
+	return &scm.Reaction{
+		Emoji:   scm.ToEmoji(from.Emoticon.Shortcut),
+		Content: from.Emoticon.Shortcut,
+		User:    *convertUser(&from.User),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jenkins-x/go-scm/scm"
+
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestReactionListPullRequestComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/comment-likes/latest/projects/PRJ/repos/my-repo/pull-requests/1/comments/5/reactions").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"size":1,"limit":25,"isLastPage":true,"start":0,"values":[{"emoticon":{"shortcut":"thumbsup"},"user":{"name":"jcitizen","slug":"jcitizen","displayName":"Jane Citizen","id":1}}]}`)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reactions.ListPullRequestCommentReactions(context.Background(), "PRJ/my-repo", 1, 5, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Len(t, got, 1)
+	assert.Equal(t, scm.EmojiThumbsUp, got[0].Emoji)
+	assert.Equal(t, "jcitizen", got[0].User.Login)
+}
+
+func TestReactionCreatePullRequestComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("rest/comment-likes/latest/projects/PRJ/repos/my-repo/pull-requests/1/comments/5/reactions/tada").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"emoticon":{"shortcut":"tada"},"user":{"name":"jcitizen","slug":"jcitizen","id":1}}`)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reactions.CreatePullRequestCommentReaction(context.Background(), "PRJ/my-repo", 1, 5, scm.EmojiHooray)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, scm.EmojiHooray, got.Emoji)
+}
+
+func TestReactionDeletePullRequestComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("rest/comment-likes/latest/projects/PRJ/repos/my-repo/pull-requests/1/comments/5/reactions/thumbsup").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reactions.DeletePullRequestCommentReaction(context.Background(), "PRJ/my-repo", 1, 5, scm.EmojiThumbsUp)
+	assert.NoError(t, err)
+}
+
+func TestReactionIssueNotSupported(t *testing.T) {
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Reactions.CreateIssueReaction(context.Background(), "PRJ/my-repo", 1, scm.EmojiHooray)
+	assert.Equal(t, scm.ErrNotSupported, err)
+}
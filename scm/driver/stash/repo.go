@@ -293,6 +293,18 @@ func (s *repositoryService) ListLabels(context.Context, string, scm.ListOptions)
 	return nil, nil, nil
 }
 
+func (s *repositoryService) CreateLabel(context.Context, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) UpdateLabel(context.Context, string, string, *scm.LabelInput) (*scm.Label, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *repositoryService) DeleteLabel(context.Context, string, string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // Find returns the repository by name.
 func (s *repositoryService) Find(ctx context.Context, repo string) (*scm.Repository, *scm.Response, error) {
 	namespace, name := scm.Split(repo)
@@ -459,6 +471,13 @@ func (s *repositoryService) Delete(context.Context, string) (*scm.Response, erro
 	return nil, scm.ErrNotSupported
 }
 
+func (s *repositoryService) SetDefaultBranch(ctx context.Context, repo, branch string) (*scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/branches/default", namespace, name)
+	in := map[string]string{"id": scm.ExpandRef(branch, "refs/heads")}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
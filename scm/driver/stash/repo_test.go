@@ -449,6 +449,21 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositorySetDefaultBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Put("/rest/api/1.0/projects/PRJ/repos/my-repo/branches/default").
+		JSON(map[string]string{"id": "refs/heads/develop"}).
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.SetDefaultBranch(context.Background(), "PRJ/my-repo", "develop")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestRepositoryHookCreate(t *testing.T) {
 	defer gock.Off()
 
@@ -6,6 +6,9 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/jenkins-x/go-scm/scm"
 )
@@ -45,3 +48,184 @@ func (s *reviewService) Submit(ctx context.Context, repo string, prID int, revie
 func (s *reviewService) Dismiss(ctx context.Context, repo string, prID int, reviewID int, msg string) (*scm.Review, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+// CreateComment creates a comment anchored to a line of the effective
+// diff. The lines of the right side are anchored as added lines and
+// the lines of the left side as removed lines.
+func (s *reviewService) CreateComment(ctx context.Context, repo string, number int, input *scm.ReviewCommentInput) (*scm.ReviewComment, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := &reviewCommentInput{
+		Text: scm.FormatSuggestion(input.Body, input.Suggestion, "suggestion"),
+		Anchor: &commentAnchor{
+			Path:     input.Path,
+			Line:     input.Line,
+			DiffType: "EFFECTIVE",
+		},
+	}
+	in.Anchor.LineType, in.Anchor.FileType = convertSide(input.Side)
+	if input.StartLine > 0 && input.StartLine < input.Line {
+		startSide := input.StartSide
+		if startSide == "" {
+			startSide = input.Side
+		}
+		marker := &multilineMarker{StartLine: input.StartLine}
+		marker.StartLineType, _ = convertSide(startSide)
+		in.Anchor.MultilineMarker = marker
+	}
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReviewComment(out), res, err
+}
+
+// ReplyToComment replies to a comment. The thread of the reply is
+// the root comment, which is found by walking up the parents of the
+// comment replied to.
+func (s *reviewService) ReplyToComment(ctx context.Context, repo string, number, id int, body string) (*scm.ReviewComment, *scm.Response, error) {
+	root, res, err := s.findRoot(ctx, repo, number, id)
+	if err != nil {
+		return nil, res, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments", namespace, name, number)
+	in := &reviewCommentInput{Text: body, Parent: &commentParent{ID: id}}
+	out := new(reviewComment)
+	res, err = s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	out.Parent = in.Parent
+	to := convertReviewComment(out)
+	to.ThreadID = strconv.Itoa(root)
+	return to, res, nil
+}
+
+// findRoot returns the id of the comment starting the thread of
+// the given comment.
+func (s *reviewService) findRoot(ctx context.Context, repo string, number, id int) (int, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	for {
+		path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+		out := new(reviewComment)
+		res, err := s.client.do(ctx, "GET", path, nil, out)
+		if err != nil {
+			return 0, res, err
+		}
+		if out.Parent == nil {
+			return id, res, nil
+		}
+		id = out.Parent.ID
+	}
+}
+
+// ResolveThread resolves the thread started by the comment, which
+// is only available since Bitbucket Server 7.x.
+func (s *reviewService) ResolveThread(ctx context.Context, repo string, number int, thread string) (*scm.Response, error) {
+	id, err := strconv.Atoi(thread)
+	if err != nil {
+		return nil, err
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", namespace, name, number, id)
+	out := new(reviewComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return res, err
+	}
+	in := &reviewCommentInput{
+		Text:           out.Text,
+		Version:        out.Version,
+		ThreadResolved: true,
+	}
+	return s.client.do(ctx, "PUT", path, in, nil)
+}
+
+type reviewComment struct {
+	ID             int            `json:"id"`
+	Version        int            `json:"version"`
+	Text           string         `json:"text"`
+	Author         user           `json:"author"`
+	CreatedDate    int64          `json:"createdDate"`
+	UpdatedDate    int64          `json:"updatedDate"`
+	ThreadResolved bool           `json:"threadResolved"`
+	Anchor         *commentAnchor `json:"anchor"`
+	Parent         *commentParent `json:"parent"`
+}
+
+type reviewCommentInput struct {
+	Text           string         `json:"text"`
+	Version        int            `json:"version,omitempty"`
+	ThreadResolved bool           `json:"threadResolved,omitempty"`
+	Anchor         *commentAnchor `json:"anchor,omitempty"`
+	Parent         *commentParent `json:"parent,omitempty"`
+}
+
+type commentAnchor struct {
+	Path            string           `json:"path"`
+	Line            int              `json:"line,omitempty"`
+	LineType        string           `json:"lineType,omitempty"`
+	FileType        string           `json:"fileType,omitempty"`
+	DiffType        string           `json:"diffType,omitempty"`
+	FromHash        string           `json:"fromHash,omitempty"`
+	ToHash          string           `json:"toHash,omitempty"`
+	MultilineMarker *multilineMarker `json:"multilineMarker,omitempty"`
+}
+
+type multilineMarker struct {
+	StartLine     int    `json:"startLine"`
+	StartLineType string `json:"startLineType"`
+}
+
+type commentParent struct {
+	ID int `json:"id"`
+}
+
+// convertSide returns the line type and the file type of the side
+// of the diff.
+func convertSide(side string) (string, string) {
+	if side == scm.SideLeft {
+		return "REMOVED", "FROM"
+	}
+	return "ADDED", "TO"
+}
+
+func convertLineType(lineType string) string {
+	if lineType == "REMOVED" {
+		return scm.SideLeft
+	}
+	return scm.SideRight
+}
+
+func convertReviewComment(from *reviewComment) *scm.ReviewComment {
+	to := &scm.ReviewComment{
+		ID:       from.ID,
+		Body:     from.Text,
+		Created:  time.Unix(from.CreatedDate/1000, 0),
+		Updated:  time.Unix(from.UpdatedDate/1000, 0),
+		ThreadID: strconv.Itoa(from.ID),
+		Resolved: from.ThreadResolved,
+		Author: scm.User{
+			Login:  from.Author.Slug,
+			Name:   from.Author.DisplayName,
+			Email:  from.Author.EmailAddress,
+			Avatar: avatarLink(from.Author.EmailAddress),
+		},
+	}
+	// the thread of a reply is only known to be the parent when
+	// the parent starts the thread.
+	if from.Parent != nil {
+		to.InReplyTo = from.Parent.ID
+		to.ThreadID = strconv.Itoa(from.Parent.ID)
+	}
+	if anchor := from.Anchor; anchor != nil {
+		to.Path = anchor.Path
+		to.Sha = anchor.ToHash
+		to.Line = anchor.Line
+		to.Side = convertLineType(anchor.LineType)
+		if marker := anchor.MultilineMarker; marker != nil {
+			to.StartLine = marker.StartLine
+			to.StartSide = convertLineType(marker.StartLineType)
+		}
+	}
+	return to
+}
@@ -6,9 +6,14 @@ package stash
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jenkins-x/go-scm/scm"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
 )
 
 func TestReviewFind(t *testing.T) {
@@ -38,3 +43,122 @@ func TestReviewDelete(t *testing.T) {
 		t.Errorf("Expect Not Supported error")
 	}
 }
+
+func TestReviewCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		File("testdata/review_comment_create.json").
+		Reply(201).
+		Type("application/json").
+		File("testdata/review_comment.json")
+
+	input := &scm.ReviewCommentInput{
+		Body:       "Use a constant.",
+		Path:       "main.go",
+		Line:       12,
+		StartLine:  10,
+		Suggestion: "const answer = 42",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.CreateComment(context.Background(), "PRJ/my-repo", 1, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := new(scm.ReviewComment)
+	raw, _ := ioutil.ReadFile("testdata/review_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewReplyToComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/42").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		BodyString(`{"text":"Done.","parent":{"id":42}}`).
+		Reply(201).
+		Type("application/json").
+		File("testdata/review_comment_reply.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ReplyToComment(context.Background(), "PRJ/my-repo", 1, 42, "Done.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 43, got.ID)
+	assert.Equal(t, 42, got.InReplyTo)
+	assert.Equal(t, "42", got.ThreadID)
+}
+
+func TestReviewReplyToComment_Nested(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/43").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"id":43,"version":0,"text":"Done.","parent":{"id":42}}`)
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/42").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments").
+		BodyString(`{"text":"Thanks.","parent":{"id":43}}`).
+		Reply(201).
+		Type("application/json").
+		BodyString(`{"id":44,"version":0,"text":"Thanks.","author":{"slug":"jcitizen"}}`)
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Reviews.ReplyToComment(context.Background(), "PRJ/my-repo", 1, 43, "Thanks.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 44, got.ID)
+	assert.Equal(t, 43, got.InReplyTo)
+	assert.Equal(t, "42", got.ThreadID)
+	assert.True(t, gock.IsDone())
+}
+
+func TestReviewResolveThread(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/42").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comment.json")
+
+	gock.New("http://example.com:7990").
+		Put("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/comments/42").
+		BodyString(`"threadResolved":true`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comment.json")
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Reviews.ResolveThread(context.Background(), "PRJ/my-repo", 1, "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, gock.IsDone())
+}
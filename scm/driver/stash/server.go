@@ -0,0 +1,34 @@
+package stash
+
+import (
+	"context"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+type serverService struct {
+	client *wrapper
+}
+
+// Find returns the server details from the application properties.
+//
+// See https://docs.atlassian.com/bitbucket-server/rest/5.11.1/bitbucket-rest.html#idm45568367094192
+func (s *serverService) Find(ctx context.Context) (*scm.ServerInfo, *scm.Response, error) {
+	out := new(applicationProperties)
+	res, err := s.client.do(ctx, "GET", "rest/api/1.0/application-properties", nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.ServerInfo{
+		Product: scm.DriverStash.String(),
+		Version: out.Version,
+		Edition: scm.EditionEnterprise,
+	}, res, nil
+}
+
+type applicationProperties struct {
+	Version     string `json:"version"`
+	BuildNumber string `json:"buildNumber"`
+	BuildDate   string `json:"buildDate"`
+	DisplayName string `json:"displayName"`
+}
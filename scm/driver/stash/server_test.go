@@ -0,0 +1,58 @@
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestServerFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://bitbucket.example.com").
+		Get("rest/api/1.0/application-properties").
+		Reply(200).
+		Type("application/json").
+		File("testdata/application_properties.json")
+
+	client, _ := New("https://bitbucket.example.com")
+	got, _, err := client.Server.Find(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.ServerInfo)
+	raw, _ := ioutil.ReadFile("testdata/application_properties.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullRequestReviewLegacyServer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://bitbucket.example.com").
+		Post("rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests/1/participants").
+		BodyString(`{"user":{"name":"jcitizen"},"role":"REVIEWER"}`).
+		Reply(200).
+		Type("application/json")
+
+	client, _ := New("https://bitbucket.example.com")
+	client.SetServerInfo(&scm.ServerInfo{Product: "stash", Version: "4.0.1"})
+	_, err := client.PullRequests.RequestReview(context.Background(), "PRJ/my-repo", 1, []string{"jcitizen"})
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Pending mocks")
+	}
+}
@@ -41,11 +41,16 @@ func New(uri string) (*scm.Client, error) {
 	client.Contents = &contentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
+	client.MergeQueues = &mergeQueueService{client}
 	client.Milestones = &milestoneService{client}
 	client.Organizations = &organizationService{client}
 	client.PullRequests = &pullService{client}
 	client.Repositories = &repositoryService{client}
 	client.Reviews = &reviewService{client}
+	client.Approvals = &approvalService{client}
+	client.Reactions = &reactionService{client}
+	client.Search = &searchService{client}
+	client.Server = &serverService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
 	return client.Client, nil
@@ -63,6 +68,13 @@ type wrapper struct {
 	*scm.Client
 }
 
+// serverOlderThan returns true if the server is known to run a
+// version older than the given one.
+func (c *wrapper) serverOlderThan(ctx context.Context, version string) bool {
+	info, err := c.ServerInfo(ctx)
+	return err == nil && info.Version != "" && !info.AtLeast(version)
+}
+
 // do wraps the Client.Do function by creating the Request and
 // unmarshalling the response.
 func (c *wrapper) do(ctx context.Context, method, path string, in, out interface{}) (*scm.Response, error) {
@@ -44,6 +44,27 @@ func encodeListRoleOptions(opts scm.ListOptions) string {
 	return params.Encode()
 }
 
+func encodeCommitListOptions(opts scm.CommitListOptions) string {
+	params := url.Values{}
+	if opts.Page > 1 {
+		params.Set("start", strconv.Itoa(
+			(opts.Page-1)*opts.Size),
+		)
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	if opts.Sha != "" {
+		params.Set("until", opts.Sha)
+	} else if opts.Ref != "" {
+		params.Set("until", opts.Ref)
+	}
+	if opts.Path != "" {
+		params.Set("path", opts.Path)
+	}
+	return params.Encode()
+}
+
 func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	params := url.Values{}
 	if opts.Page > 1 {
@@ -25,6 +25,22 @@ func (e ExtraUsers) Error() string {
 	return fmt.Sprintf("could not %s the following user(s): %s.", e.Action, strings.Join(e.Users, ", "))
 }
 
+// MissingScopes is an error specifying the scopes, any of which
+// the token must be granted to access the resource.
+type MissingScopes struct {
+	Scopes []string
+}
+
+func (m MissingScopes) Error() string {
+	return fmt.Sprintf("token is missing one of the following scope(s): %s.", strings.Join(m.Scopes, ", "))
+}
+
+// IsMissingScopes returns true if the error is a missing scopes error
+func IsMissingScopes(err error) bool {
+	_, ok := err.(MissingScopes)
+	return ok
+}
+
 // UnknownWebhook if the webhook is unknown
 type UnknownWebhook struct {
 	Event string
@@ -60,3 +76,22 @@ type MissingHeader struct {
 func (e MissingHeader) Error() string {
 	return fmt.Sprintf("400 Bad Request: Missing Header: %s", e.Header)
 }
+
+// MergeConflict is an error specifying that a merge, rebase or
+// branch update was rejected because of conflicts.
+type MergeConflict struct {
+	Message string
+}
+
+func (m MergeConflict) Error() string {
+	if m.Message == "" {
+		return "merge conflict"
+	}
+	return fmt.Sprintf("merge conflict: %s", m.Message)
+}
+
+// IsMergeConflict returns true if the error is a merge conflict error
+func IsMergeConflict(err error) bool {
+	_, ok := err.(MergeConflict)
+	return ok
+}
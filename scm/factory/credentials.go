@@ -0,0 +1,490 @@
+package factory
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/transport"
+	"github.com/pkg/errors"
+)
+
+// ErrMissingCredentials is returned when no credential provider
+// has a credential for the git server.
+var ErrMissingCredentials = fmt.Errorf("No git credentials found")
+
+// Credential is the username and token used to authenticate
+// with a git server.
+type Credential struct {
+	Username string
+	Token    string
+}
+
+// CredentialProvider looks up the credential for a git server
+// host. It returns a nil credential and no error if it has no
+// credential for the host.
+type CredentialProvider interface {
+	Credential(ctx context.Context, host string) (*Credential, error)
+}
+
+// CredentialProviderFunc adapts a function to a CredentialProvider
+// so that callers can plug in their own credential lookup.
+type CredentialProviderFunc func(ctx context.Context, host string) (*Credential, error)
+
+// Credential calls f(ctx, host).
+func (f CredentialProviderFunc) Credential(ctx context.Context, host string) (*Credential, error) {
+	return f(ctx, host)
+}
+
+// CredentialChain is a CredentialProvider which returns the
+// credential of the first provider that has one for the host.
+type CredentialChain []CredentialProvider
+
+// Credential returns the first credential found for the host.
+func (c CredentialChain) Credential(ctx context.Context, host string) (*Credential, error) {
+	for _, p := range c {
+		cred, err := p.Credential(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		if cred != nil && cred.Token != "" {
+			return cred, nil
+		}
+	}
+	return nil, nil
+}
+
+// DefaultCredentialProviders returns the default credential chain:
+// the $GIT_TOKEN environment variable, the files referenced by
+// $GIT_TOKEN_FILE and $GIT_USER_FILE, the ~/.netrc file and
+// finally the git credential helper.
+func DefaultCredentialProviders() CredentialChain {
+	chain := CredentialChain{&EnvCredentials{}}
+	if path := os.Getenv("GIT_TOKEN_FILE"); path != "" {
+		chain = append(chain, &FileCredentials{
+			TokenFile:    path,
+			UsernameFile: os.Getenv("GIT_USER_FILE"),
+		})
+	}
+	return append(chain, &NetrcCredentials{}, &GitCredentialHelper{})
+}
+
+// EnvCredentials reads the credential from the $GIT_TOKEN and
+// $GIT_USER (or $GIT_USERNAME) environment variables. If
+// $GIT_SERVER is set the credential is only used for its host.
+type EnvCredentials struct{}
+
+// Credential returns the credential from the environment.
+func (p *EnvCredentials) Credential(ctx context.Context, host string) (*Credential, error) {
+	token := os.Getenv("GIT_TOKEN")
+	if token == "" {
+		return nil, nil
+	}
+	if server := os.Getenv("GIT_SERVER"); server != "" && !sameHost(hostOf(server), host) {
+		return nil, nil
+	}
+	username := os.Getenv("GIT_USER")
+	if username == "" {
+		username = os.Getenv("GIT_USERNAME")
+	}
+	return &Credential{Username: username, Token: token}, nil
+}
+
+// FileCredentials reads the credential from files, such as a
+// mounted Kubernetes secret. The files are re-read whenever they
+// change so that rotated secrets are picked up without a restart.
+type FileCredentials struct {
+	TokenFile    string
+	UsernameFile string // optional
+
+	// Host restricts the credential to a single git server
+	// host. If empty the credential is used for any host.
+	Host string
+
+	mu       sync.Mutex
+	modified time.Time
+	cred     *Credential
+}
+
+// Credential returns the credential read from the files.
+func (p *FileCredentials) Credential(ctx context.Context, host string) (*Credential, error) {
+	if p.Host != "" && !sameHost(p.Host, host) {
+		return nil, nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	modified, err := latestModTime(p.TokenFile, p.UsernameFile)
+	if err != nil {
+		return nil, err
+	}
+	if p.cred != nil && modified.Equal(p.modified) {
+		return p.cred, nil
+	}
+	token, err := readTrimmed(p.TokenFile)
+	if err != nil {
+		return nil, err
+	}
+	cred := &Credential{Token: token}
+	if p.UsernameFile != "" {
+		if cred.Username, err = readTrimmed(p.UsernameFile); err != nil {
+			return nil, err
+		}
+	}
+	p.cred, p.modified = cred, modified
+	return cred, nil
+}
+
+// NetrcCredentials reads the credential of the host from a netrc
+// file. The file is re-read whenever it changes.
+type NetrcCredentials struct {
+	// Path of the netrc file, defaulting to $NETRC or ~/.netrc.
+	Path string
+
+	mu       sync.Mutex
+	modified time.Time
+	machines map[string]*Credential
+}
+
+// Credential returns the credential of the host from the netrc
+// file, falling back to the default entry.
+func (p *NetrcCredentials) Credential(ctx context.Context, host string) (*Credential, error) {
+	path := p.path()
+	if path == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.machines == nil || !info.ModTime().Equal(p.modified) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		p.machines, p.modified = parseNetrc(data), info.ModTime()
+	}
+	if cred, ok := p.machines[host]; ok {
+		return cred, nil
+	}
+	if cred, ok := p.machines[hostname(host)]; ok {
+		return cred, nil
+	}
+	return p.machines[""], nil
+}
+
+func (p *NetrcCredentials) path() string {
+	if p.Path != "" {
+		return p.Path
+	}
+	if path := os.Getenv("NETRC"); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".netrc")
+}
+
+// gitCredentialTTL is how long a credential from the git
+// credential helper is cached by default.
+const gitCredentialTTL = 5 * time.Minute
+
+// GitCredentialHelper looks up the credential of the host with
+// `git credential fill`, which uses the credential helpers
+// configured for git. Credentials are cached per host for the TTL
+// so that rotated credentials are picked up.
+type GitCredentialHelper struct {
+	// Protocol used to look up the credential, defaulting to https.
+	Protocol string
+
+	// TTL is how long a credential is cached, defaulting to
+	// five minutes.
+	TTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*cachedCredential
+}
+
+type cachedCredential struct {
+	cred    *Credential
+	expires time.Time
+}
+
+// Credential returns the credential of the host from git.
+func (p *GitCredentialHelper) Credential(ctx context.Context, host string) (*Credential, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cached, ok := p.cache[host]; ok && time.Now().Before(cached.expires) {
+		return cached.cred, nil
+	}
+	delete(p.cache, host)
+
+	protocol := p.Protocol
+	if protocol == "" {
+		protocol = "https"
+	}
+	cmd := exec.CommandContext(ctx, "git", "credential", "fill")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("protocol=%s\nhost=%s\n\n", protocol, host))
+	// never prompt the user for a credential on the terminal
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		switch err.(type) {
+		case *exec.ExitError, *exec.Error:
+			// git is not installed or no helper has a credential
+			return nil, nil
+		}
+		return nil, err
+	}
+	cred := parseGitCredential(out)
+	if cred == nil {
+		return nil, nil
+	}
+	ttl := p.TTL
+	if ttl == 0 {
+		ttl = gitCredentialTTL
+	}
+	if p.cache == nil {
+		p.cache = map[string]*cachedCredential{}
+	}
+	p.cache[host] = &cachedCredential{cred: cred, expires: time.Now().Add(ttl)}
+	return cred, nil
+}
+
+// NewClientWithCredentials creates a new client for a given driver and serverURL, looking up the
+// credential for the server host with the provider on every request so that rotated credentials
+// are used without recreating the client. If no driver is given it is identified from the host
+func NewClientWithCredentials(driver, serverURL string, provider CredentialProvider, opts ...ClientOptionFunc) (*scm.Client, error) {
+	host := hostOf(serverURL)
+	if driver == "" && host != "" {
+		var err error
+		if driver, err = DefaultIdentifier.Identify(host); err != nil {
+			return nil, err
+		}
+	}
+	if host == "" {
+		host = defaultHost(driver)
+	}
+	cred, err := provider.Credential(context.Background(), host)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil || cred.Token == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	client, err := newClient(driver, serverURL, &AuthOptions{})
+	if err != nil {
+		return client, err
+	}
+	client.Username = cred.Username
+	client.Client = &http.Client{
+		Transport: &credentialTransport{
+			client:   client,
+			host:     host,
+			provider: provider,
+		},
+	}
+	for _, o := range opts {
+		o(client)
+	}
+	// bitbucket cloud authenticates app passwords with the username
+	if client.Driver == scm.DriverBitbucket && client.Username == "" {
+		return nil, errors.Errorf("no username supplied")
+	}
+	return client, nil
+}
+
+// credentialTransport is an http.RoundTripper which authenticates
+// each request with the current credential of the host, using the
+// authentication scheme of the driver.
+type credentialTransport struct {
+	client   *scm.Client // username used if the credential has none
+	host     string
+	provider CredentialProvider
+}
+
+// RoundTrip authenticates the request with the current credential.
+func (t *credentialTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	cred, err := t.provider.Credential(r.Context(), t.host)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil || cred.Token == "" {
+		return nil, ErrMissingCredentials
+	}
+	var rt http.RoundTripper
+	switch t.client.Driver {
+	case scm.DriverGitea:
+		rt = &transport.Authorization{Scheme: "token", Credentials: cred.Token}
+	case scm.DriverGitlab:
+		rt = &transport.PrivateToken{Token: cred.Token}
+	case scm.DriverBitbucket:
+		username := cred.Username
+		if username == "" {
+			username = t.client.Username
+		}
+		if username == "" {
+			return nil, errors.Errorf("no username supplied")
+		}
+		rt = &transport.BasicAuth{Username: username, Password: cred.Token}
+	default:
+		rt = &transport.BearerToken{Token: cred.Token}
+	}
+	return rt.RoundTrip(r)
+}
+
+// parseNetrc parses the machine entries of a netrc file, keyed by
+// machine name. The default entry has an empty key.
+func parseNetrc(data []byte) map[string]*Credential {
+	machines := map[string]*Credential{}
+	var current *Credential
+	inMacro := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if inMacro {
+			// macro definitions end with an empty line
+			inMacro = strings.TrimSpace(line) != ""
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		for i := 0; i < len(fields); i++ {
+			switch fields[i] {
+			case "machine":
+				if i+1 < len(fields) {
+					i++
+					current = &Credential{}
+					machines[fields[i]] = current
+				}
+			case "default":
+				current = &Credential{}
+				machines[""] = current
+			case "login":
+				if i+1 < len(fields) && current != nil {
+					i++
+					current.Username = fields[i]
+				}
+			case "password":
+				if i+1 < len(fields) && current != nil {
+					i++
+					current.Token = fields[i]
+				}
+			case "account":
+				i++
+			case "macdef":
+				inMacro = true
+				i = len(fields)
+			}
+		}
+	}
+	return machines
+}
+
+// parseGitCredential parses the output of git credential fill.
+func parseGitCredential(data []byte) *Credential {
+	cred := &Credential{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "username":
+			cred.Username = parts[1]
+		case "password":
+			cred.Token = parts[1]
+		}
+	}
+	if cred.Token == "" {
+		return nil
+	}
+	return cred
+}
+
+// latestModTime returns the latest modification time of the files,
+// ignoring empty paths.
+func latestModTime(paths ...string) (time.Time, error) {
+	var latest time.Time
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return latest, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func readTrimmed(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// hostOf returns the host of the server URL, which may also be a
+// plain host name.
+func hostOf(serverURL string) string {
+	if serverURL == "" {
+		return ""
+	}
+	if !strings.Contains(serverURL, "://") {
+		serverURL = "https://" + serverURL
+	}
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// defaultHost returns the host of the SaaS offering of the driver.
+func defaultHost(driver string) string {
+	switch driver {
+	case "bitbucket", "bitbucketcloud":
+		return "bitbucket.org"
+	case "gitlab":
+		return "gitlab.com"
+	default:
+		return "github.com"
+	}
+}
+
+// hostname strips the port from the host.
+func hostname(host string) string {
+	return (&url.URL{Host: host}).Hostname()
+}
+
+func sameHost(a, b string) bool {
+	return strings.EqualFold(a, b) || strings.EqualFold(hostname(a), hostname(b))
+}
@@ -0,0 +1,214 @@
+package factory
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCredentialChain(t *testing.T) {
+	chain := CredentialChain{
+		CredentialProviderFunc(func(ctx context.Context, host string) (*Credential, error) {
+			return nil, nil
+		}),
+		CredentialProviderFunc(func(ctx context.Context, host string) (*Credential, error) {
+			if host != "gitlab.com" {
+				return nil, nil
+			}
+			return &Credential{Username: "jdoe", Token: "abc123"}, nil
+		}),
+	}
+	cred, err := chain.Credential(context.Background(), "gitlab.com")
+	require.NoError(t, err)
+	assert.Equal(t, &Credential{Username: "jdoe", Token: "abc123"}, cred)
+
+	cred, err = chain.Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Nil(t, cred)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	defer restoreEnv("GIT_TOKEN", "GIT_USER", "GIT_SERVER")()
+	os.Setenv("GIT_TOKEN", "abc123")
+	os.Setenv("GIT_USER", "jdoe")
+	os.Setenv("GIT_SERVER", "https://gitlab.example.com")
+
+	p := &EnvCredentials{}
+	cred, err := p.Credential(context.Background(), "gitlab.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &Credential{Username: "jdoe", Token: "abc123"}, cred)
+
+	cred, err = p.Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Nil(t, cred, "credential should be restricted to $GIT_SERVER")
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tokenFile := filepath.Join(dir, "token")
+	userFile := filepath.Join(dir, "username")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("abc123\n"), 0600))
+	require.NoError(t, ioutil.WriteFile(userFile, []byte("jdoe\n"), 0600))
+
+	p := &FileCredentials{TokenFile: tokenFile, UsernameFile: userFile}
+	cred, err := p.Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Equal(t, &Credential{Username: "jdoe", Token: "abc123"}, cred)
+
+	// rotate the secret
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("def456"), 0600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(tokenFile, later, later))
+
+	cred, err = p.Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Equal(t, "def456", cred.Token, "rotated token should be re-read")
+}
+
+func TestNetrcCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netrc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, ".netrc")
+	data := `# git servers
+machine github.com login octocat password abc123
+machine gitlab.example.com
+  login jdoe
+  password def456
+
+macdef init
+machine ignored.com login nobody password secret
+
+default login anonymous password ghi789
+`
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+
+	p := &NetrcCredentials{Path: path}
+	tests := []struct {
+		host string
+		want *Credential
+	}{
+		{"github.com", &Credential{Username: "octocat", Token: "abc123"}},
+		{"gitlab.example.com:8443", &Credential{Username: "jdoe", Token: "def456"}},
+		{"ignored.com", &Credential{Username: "anonymous", Token: "ghi789"}},
+	}
+	for _, test := range tests {
+		cred, err := p.Credential(context.Background(), test.host)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, cred, test.host)
+	}
+
+	cred, err := (&NetrcCredentials{Path: filepath.Join(dir, "missing")}).Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Nil(t, cred)
+}
+
+func TestParseGitCredential(t *testing.T) {
+	out := []byte("protocol=https\nhost=github.com\nusername=octocat\npassword=abc123\n")
+	assert.Equal(t, &Credential{Username: "octocat", Token: "abc123"}, parseGitCredential(out))
+	assert.Nil(t, parseGitCredential([]byte("protocol=https\nhost=github.com\n")))
+}
+
+func TestNewClientWithCredentials(t *testing.T) {
+	defer gock.Off()
+
+	token := "abc123"
+	provider := CredentialProviderFunc(func(ctx context.Context, host string) (*Credential, error) {
+		assert.Equal(t, "gitlab.com", host)
+		return &Credential{Username: "jdoe", Token: token}, nil
+	})
+
+	client, err := NewClientWithCredentials("", "https://gitlab.com", provider)
+	require.NoError(t, err)
+	assert.Equal(t, scm.DriverGitlab, client.Driver)
+	assert.Equal(t, "jdoe", client.Username)
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		MatchHeader("Private-Token", "abc123").
+		Reply(200)
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		MatchHeader("Private-Token", "def456").
+		Reply(200)
+
+	for _, next := range []string{"abc123", "def456"} {
+		token = next
+		res, err := client.Client.Get("https://gitlab.com/api/v4/user")
+		require.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.True(t, gock.IsDone(), "each request should use the current credential")
+
+	_, err = NewClientWithCredentials("github", "", CredentialChain{})
+	assert.Equal(t, ErrMissingCredentials, err)
+}
+
+func TestNewClientWithCredentials_BitbucketUsername(t *testing.T) {
+	provider := CredentialProviderFunc(func(ctx context.Context, host string) (*Credential, error) {
+		return &Credential{Token: "abc123"}, nil
+	})
+	_, err := NewClientWithCredentials("bitbucketcloud", "https://bitbucket.org", provider)
+	assert.EqualError(t, err, "no username supplied")
+
+	client, err := NewClientWithCredentials("bitbucketcloud", "https://bitbucket.org", provider, SetUsername("jdoe"))
+	require.NoError(t, err)
+	assert.Equal(t, "jdoe", client.Username)
+}
+
+func TestGitCredentialHelper_Expiry(t *testing.T) {
+	defer restoreEnv("HOME", "GIT_CONFIG_NOSYSTEM")()
+	os.Setenv("HOME", t.TempDir())
+	os.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	cred := &Credential{Username: "octocat", Token: "abc123"}
+	helper := &GitCredentialHelper{cache: map[string]*cachedCredential{
+		"github.com": {cred: cred, expires: time.Now().Add(time.Minute)},
+		"gitlab.com": {cred: cred, expires: time.Now().Add(-time.Minute)},
+	}}
+
+	got, err := helper.Credential(context.Background(), "github.com")
+	require.NoError(t, err)
+	assert.Equal(t, cred, got)
+
+	// the expired credential is looked up again, and no helper is configured
+	got, err = helper.Credential(context.Background(), "gitlab.com")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestNewClientFromEnvironment_MissingToken(t *testing.T) {
+	defer restoreEnv("GIT_REPO_URL", "GIT_TOKEN")()
+	os.Setenv("GIT_REPO_URL", "")
+	os.Setenv("GIT_TOKEN", "")
+
+	_, err := NewClientFromEnvironment()
+	assert.EqualError(t, err, "No Git OAuth token specified for $GIT_TOKEN")
+}
+
+// restoreEnv returns a function restoring the environment variables.
+func restoreEnv(names ...string) func() {
+	values := map[string]string{}
+	for _, name := range names {
+		values[name] = os.Getenv(name)
+	}
+	return func() {
+		for name, value := range values {
+			os.Setenv(name, value)
+		}
+	}
+}
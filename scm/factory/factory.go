@@ -36,6 +36,17 @@ type AuthOptions struct {
 	oauthToken   string
 	clientID     string
 	clientSecret string
+	githubApp    *GitHubAppAuth
+}
+
+// GitHubAppAuth contains the credentials used to authenticate as an
+// installation of a GitHub App. If no InstallationID is given the
+// installation is resolved from the Repository.
+type GitHubAppAuth struct {
+	AppID          int64
+	PrivateKey     []byte // PEM encoded private key of the app
+	InstallationID int64
+	Repository     string
 }
 
 // SetUsername allows the username to be set
@@ -81,6 +92,15 @@ func NewClient(driver, serverURL, oauthToken string, opts ...ClientOptionFunc) (
 	return newClient(driver, serverURL, authOptions, opts...)
 }
 
+// NewClientWithGitHubApp creates a new GitHub client for a given serverURL that authenticates
+// as an installation of a GitHub App, refreshing the installation token before it expires
+func NewClientWithGitHubApp(serverURL string, app GitHubAppAuth, opts ...ClientOptionFunc) (*scm.Client, error) {
+	authOptions := &AuthOptions{
+		githubApp: &app,
+	}
+	return newClient("github", serverURL, authOptions, opts...)
+}
+
 func newClient(driver, serverURL string, authOptions *AuthOptions, opts ...ClientOptionFunc) (*scm.Client, error) {
 	oauthToken := authOptions.oauthToken
 	if driver == "" {
@@ -131,6 +151,16 @@ func newClient(driver, serverURL string, authOptions *AuthOptions, opts ...Clien
 	if err != nil {
 		return client, err
 	}
+	if authOptions.githubApp != nil {
+		if driver != "github" {
+			return nil, errors.Errorf("GitHub App authentication is not supported by driver %s", driver)
+		}
+		rt, err := newGitHubAppTransport(client, authOptions.githubApp)
+		if err != nil {
+			return nil, err
+		}
+		client.Client = &http.Client{Transport: rt}
+	}
 	if oauthToken != "" {
 		switch driver {
 		case "gitea":
@@ -185,7 +215,8 @@ func newClient(driver, serverURL string, authOptions *AuthOptions, opts ...Clien
 }
 
 // NewClientFromEnvironment creates a new client using environment variables $GIT_KIND, $GIT_SERVER, $GIT_TOKEN
-// defaulting to github if no $GIT_KIND or $GIT_SERVER
+// defaulting to github if no $GIT_KIND or $GIT_SERVER. Use NewClientWithCredentials with the
+// DefaultCredentialProviders to also look up the credential in ~/.netrc and the git credential helper
 func NewClientFromEnvironment() (*scm.Client, error) {
 	if repoURL := os.Getenv("GIT_REPO_URL"); repoURL != "" {
 		return FromRepoURL(repoURL)
@@ -240,6 +271,30 @@ func FromRepoURL(repoURL string) (*scm.Client, error) {
 	return NewClient(driver, u.String(), auth)
 }
 
+// newGitHubAppTransport creates the transport which authenticates requests with the
+// installation tokens of a GitHub App, using a JWT authenticated client to create them
+func newGitHubAppTransport(client *scm.Client, app *GitHubAppAuth) (http.RoundTripper, error) {
+	key, err := transport.ParseGitHubAppKey(app.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	appClient, err := github.New(strings.TrimSuffix(client.BaseURL.String(), "/"))
+	if err != nil {
+		return nil, err
+	}
+	appClient.Client = &http.Client{
+		Transport: &transport.GitHubAppJWT{
+			AppID:      app.AppID,
+			PrivateKey: key,
+		},
+	}
+	return &transport.GitHubApp{
+		Tokens:         &transport.GitHubAppTokens{Apps: appClient.Apps},
+		InstallationID: app.InstallationID,
+		Repository:     app.Repository,
+	}, nil
+}
+
 // ensureGHEEndpoint lets ensure we have the /api/v3 suffix on the URL
 func ensureGHEEndpoint(u string) string {
 	if strings.HasPrefix(u, "https://github.com") || strings.HasPrefix(u, "http://github.com") {
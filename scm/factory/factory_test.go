@@ -1,6 +1,10 @@
 package factory
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"net/http"
 	"testing"
 
@@ -50,3 +54,32 @@ func TestFromRepoURL(t *testing.T) {
 		t.Fatalf("got %q, want %q", p, "abc123")
 	}
 }
+
+func TestNewClientWithGitHubApp(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := GitHubAppAuth{
+		AppID:      42,
+		PrivateKey: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		Repository: "octocat/hello-world",
+	}
+	client, err := NewClientWithGitHubApp("https://github.example.com", app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := client.BaseURL.String(), "https://github.example.com/api/v3/"; got != want {
+		t.Errorf("BaseURL got %q, want %q", got, want)
+	}
+	rt, ok := client.Client.Transport.(*transport.GitHubApp)
+	if !ok {
+		t.Fatalf("Want GitHub App transport, got %T", client.Client.Transport)
+	}
+	assert.Equal(t, "octocat/hello-world", rt.Repository)
+
+	app.PrivateKey = []byte("invalid")
+	if _, err := NewClientWithGitHubApp("", app); err == nil {
+		t.Errorf("Expect error with an invalid private key")
+	}
+}
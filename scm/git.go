@@ -6,18 +6,95 @@ package scm
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
 // EmptyCommit is an empty commit sha.
 const EmptyCommit = "0000000000000000000000000000000000000000"
 
+// ErrRefMismatch indicates a reference was not updated because
+// it does not point to the expected commit.
+var ErrRefMismatch = errors.New("Reference does not point to the expected commit")
+
+// ErrTagMessageRequired indicates an annotated tag was not created
+// because the input has no message.
+var ErrTagMessageRequired = errors.New("Annotated tags require a message")
+
+// IsMerge returns true if the commit has more than one parent.
+func (c *Commit) IsMerge() bool {
+	return len(c.Parents) > 1
+}
+
+// Tree entry modes.
+const (
+	TreeModeFile       = "100644"
+	TreeModeExecutable = "100755"
+	TreeModeDir        = "040000"
+	TreeModeSubmodule  = "160000"
+	TreeModeSymlink    = "120000"
+)
+
+// FileAction identifies the change made to a file in a
+// multi-file commit.
+type FileAction string
+
+// FileAction values.
+const (
+	FileActionCreate FileAction = "create"
+	FileActionUpdate FileAction = "update"
+	FileActionDelete FileAction = "delete"
+	FileActionMove   FileAction = "move"
+)
+
 type (
 	// Reference represents a git reference.
 	Reference struct {
 		Name string
 		Path string
 		Sha  string
+
+		// Protected is only set for branches by the GitHub,
+		// GitLab and Gitea drivers.
+		Protected bool
+
+		// Date is the date of the head commit. It is only set
+		// for branches by the GitLab, Gitea and Bitbucket Cloud
+		// drivers, and by GitHub FindBranch; the GitHub branch
+		// list does not include it.
+		Date time.Time
+	}
+
+	// BranchInput provides the input fields required for
+	// creating a branch.
+	BranchInput struct {
+		Name string
+		Ref  string // branch, tag or commit sha to branch from
+	}
+
+	// Tag represents a git tag. A lightweight tag has no tag
+	// object, so only the Name and Target are set.
+	Tag struct {
+		Name         string
+		Sha          string // sha of the tag object
+		Message      string
+		Tagger       Signature
+		Target       string // sha of the tagged object
+		TargetType   string // commit, tree, blob or tag
+		Verification *Verification
+	}
+
+	// TagInput provides the input fields required for
+	// creating an annotated tag. The Message is required, since
+	// some providers otherwise create a lightweight tag. The
+	// Tagger is only supported by GitHub; other drivers tag as
+	// the authenticated user and reject a Tagger with
+	// ErrNotSupported.
+	TagInput struct {
+		Name    string
+		Message string
+		Target  string     // sha of the commit to tag
+		Tagger  *Signature // optional, defaults to the authenticated user
 	}
 
 	// CommitTree represents a commit tree
@@ -34,6 +111,127 @@ type (
 		Author    Signature
 		Committer Signature
 		Link      string
+
+		// Fields are optional. The provider may not include
+		// them in the response, in particular when listing
+		// commits.
+		Parents      []string
+		Stats        *CommitStats
+		Files        []*Change
+		Verification *Verification
+	}
+
+	// CommitStats represents the number of lines changed
+	// by a commit.
+	CommitStats struct {
+		Additions int
+		Deletions int
+		Total     int
+	}
+
+	// Verification represents the verification of a signed
+	// commit or tag.
+	Verification struct {
+		Verified bool
+
+		// Reason is the provider specific verification status,
+		// such as "valid" or "unsigned" on GitHub and "verified"
+		// or "unverified_key" on GitLab.
+		Reason    string
+		Signer    string
+		Signature string
+	}
+
+	// CommitInput provides the input fields required for
+	// creating a git commit from a tree.
+	CommitInput struct {
+		Message   string
+		Tree      string
+		Parents   []string
+		Author    *Signature // optional, defaults to the authenticated user
+		Committer *Signature // optional, defaults to the author
+	}
+
+	// Blob represents a git blob.
+	Blob struct {
+		Sha     string
+		Size    int64
+		Content []byte
+	}
+
+	// Tree represents a git tree.
+	Tree struct {
+		Sha       string
+		Entries   []*TreeEntry
+		Truncated bool // true if the provider limited the number of entries
+	}
+
+	// TreeEntry represents an entry of a git tree.
+	TreeEntry struct {
+		Path string
+		Mode string
+		Type string // blob, tree or commit
+		Sha  string
+		Size int64
+	}
+
+	// TreeInput provides the input fields required for
+	// creating a git tree. Entries are applied on top of the
+	// base tree, when set. An entry with an empty Sha removes
+	// the path from the base tree.
+	TreeInput struct {
+		BaseTree string
+		Entries  []*TreeEntry
+	}
+
+	// RefInput provides the input fields required for
+	// updating a git reference.
+	RefInput struct {
+		Sha string
+
+		// Force allows the update when it is not a fast
+		// forward of the current commit.
+		Force bool
+
+		// OldSha, when set, makes the update fail with
+		// ErrRefMismatch unless the reference currently
+		// points to this commit. The check is not atomic:
+		// drivers read the reference before updating it, so
+		// a concurrent update between the two is not detected.
+		OldSha string
+	}
+
+	// FileChange describes a change made to a file in a
+	// multi-file commit.
+	FileChange struct {
+		Action       FileAction
+		Path         string
+		PreviousPath string // source path of a move
+		Content      []byte
+		Executable   bool // not supported by Gitea
+	}
+
+	// CommitFilesInput provides the input fields required
+	// for atomically committing changes to many files.
+	CommitFilesInput struct {
+		Branch  string
+		Message string
+		Files   []*FileChange
+
+		// BaseBranch is the branch the commit is based on when
+		// Branch does not exist yet, in which case Branch is
+		// created.
+		BaseBranch string
+
+		Author *Signature // optional, defaults to the authenticated user
+	}
+
+	// MergeInput provides the input fields required for
+	// merging a branch into another.
+	MergeInput struct {
+		Base    string // branch to merge into
+		Head    string // branch, tag or commit sha to merge
+		Message string // optional, defaults to the provider message
 	}
 
 	// CommitListOptions provides options for querying a
@@ -43,6 +241,23 @@ type (
 		Sha  string
 		Page int
 		Size int
+
+		// Path limits the list to commits touching the file
+		// or directory.
+		Path string
+
+		// Author limits the list to commits by the author
+		// login, name or email, depending on the provider.
+		Author string
+
+		// Since and Until limit the list to commits created
+		// in the time window.
+		Since time.Time
+		Until time.Time
+
+		// FirstParent only follows the first parent of merge
+		// commits.
+		FirstParent bool
 	}
 
 	// Signature identifies a git commit creator.
@@ -62,12 +277,22 @@ type (
 		// FindBranch finds a git branch by name.
 		FindBranch(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
+		// CreateBranch creates a branch from the given ref.
+		CreateBranch(ctx context.Context, repo string, input *BranchInput) (*Reference, *Response, error)
+
+		// RenameBranch renames a git branch.
+		RenameBranch(ctx context.Context, repo, branch, name string) (*Reference, *Response, error)
+
 		// FindCommit finds a git commit by ref.
 		FindCommit(ctx context.Context, repo, ref string) (*Commit, *Response, error)
 
 		// FindTag finds a git tag by name.
 		FindTag(ctx context.Context, repo, name string) (*Reference, *Response, error)
 
+		// FindTagObject finds a git tag by name, including the
+		// message and tagger of an annotated tag.
+		FindTagObject(ctx context.Context, repo, name string) (*Tag, *Response, error)
+
 		// ListBranches returns a list of git branches.
 		ListBranches(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
@@ -80,7 +305,8 @@ type (
 		// ListChanges returns the changeset between two commits.
 		CompareCommits(ctx context.Context, repo, ref1, ref2 string, opts ListOptions) ([]*Change, *Response, error)
 
-		// ListTags returns a list of git tags.
+		// ListTags returns a list of git tags. Use FindTagObject
+		// to read the message and tagger of an annotated tag.
 		ListTags(ctx context.Context, repo string, opts ListOptions) ([]*Reference, *Response, error)
 
 		// FindRef returns the SHA of the given ref, such as "heads/master".
@@ -91,5 +317,39 @@ type (
 
 		// CreateRef creates a new ref
 		CreateRef(ctx context.Context, repo, ref, sha string) (*Reference, *Response, error)
+
+		// CreateTag creates an annotated tag.
+		CreateTag(ctx context.Context, repo string, input *TagInput) (*Tag, *Response, error)
+
+		// UpdateRef moves the given ref, such as "heads/master",
+		// to another commit.
+		UpdateRef(ctx context.Context, repo, ref string, input *RefInput) (*Reference, *Response, error)
+
+		// FindBlob finds a git blob by sha.
+		FindBlob(ctx context.Context, repo, sha string) (*Blob, *Response, error)
+
+		// CreateBlob creates a git blob with the given content.
+		CreateBlob(ctx context.Context, repo string, content []byte) (*Blob, *Response, error)
+
+		// FindTree finds a git tree by sha or ref, optionally
+		// including the entries of all subtrees.
+		FindTree(ctx context.Context, repo, sha string, recursive bool) (*Tree, *Response, error)
+
+		// CreateTree creates a git tree.
+		CreateTree(ctx context.Context, repo string, input *TreeInput) (*Tree, *Response, error)
+
+		// CreateCommit creates a git commit without updating
+		// any reference.
+		CreateCommit(ctx context.Context, repo string, input *CommitInput) (*Commit, *Response, error)
+
+		// CommitFiles atomically commits the changes of many
+		// files to a branch as a single commit.
+		CommitFiles(ctx context.Context, repo string, input *CommitFilesInput) (*Commit, *Response, error)
+
+		// MergeBranch merges the head into the base branch
+		// without a pull request. It returns the merge commit,
+		// or nil if the base already contains the head, and a
+		// MergeConflict error if the merge has conflicts.
+		MergeBranch(ctx context.Context, repo string, input *MergeInput) (*Commit, *Response, error)
 	}
 )
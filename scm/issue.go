@@ -18,15 +18,26 @@ type (
 		Body        string
 		Link        string
 		State       string
-		Labels      []string
+		Labels      []*Label
 		Closed      bool
 		Locked      bool
 		Author      User
 		Assignees   []User
 		ClosedBy    *User
+		Milestone   *Milestone
+		Type        string     // issue type, such as incident on GitLab
+		Weight      int        // GitLab only
+		DueDate     *time.Time // GitLab and Gitea only
 		PullRequest bool
 		Created     time.Time
 		Updated     time.Time
+		ClosedAt    *time.Time
+
+		// LinkedPullRequests are the numbers of the pull requests
+		// which close the issue when merged. They are only
+		// populated by IssueService.Find, and are nil if the
+		// provider could not look them up.
+		LinkedPullRequests []int
 	}
 
 	// SearchIssue for the results of a search which queries across repositories
@@ -38,17 +49,29 @@ type (
 	// IssueInput provides the input fields required for
 	// creating or updating an issue.
 	IssueInput struct {
-		Title string
-		Body  string
+		Title     string
+		Body      string
+		Labels    []string
+		Assignees []string // user logins
+		Milestone int      // milestone number
 	}
 
 	// IssueListOptions provides options for querying a
-	// list of repository issues.
+	// list of repository issues. Filters the provider cannot
+	// apply result in ErrNotSupported.
 	IssueListOptions struct {
-		Page   int
-		Size   int
-		Open   bool
-		Closed bool
+		Page         int
+		Size         int
+		Open         bool
+		Closed       bool
+		Labels       []string
+		Assignee     string     // login of the assignee, the account id on Bitbucket Cloud
+		Author       string     // login of the issue author, the account id on Bitbucket Cloud
+		Mentioned    string     // login of a mentioned user
+		Milestone    int        // milestone number
+		UpdatedAfter *time.Time // also known as since
+		Sort         string     // created, updated or comments
+		Ascending    bool
 	}
 
 	// Comment represents a comment.
@@ -0,0 +1,142 @@
+package labels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// SyncResult reports the changes applied by SyncLabels.
+type SyncResult struct {
+	Created   []*scm.Label
+	Updated   []*scm.Label
+	Deleted   []*scm.Label
+	Unchanged []*scm.Label
+
+	// Comments is true if the provider has no repository labels, in
+	// which case the labels are added to issues and pull requests
+	// with the comments created by CreateLabelAddComment and nothing
+	// is changed in the repository. The desired labels are then
+	// reported as Skipped.
+	Comments bool
+	Skipped  []*scm.Label
+}
+
+// CommentLabels returns true if the provider has no native labels
+// and the labels are managed with comments.
+func CommentLabels(driver scm.Driver) bool {
+	switch driver {
+	case scm.DriverBitbucket, scm.DriverStash:
+		return true
+	default:
+		return false
+	}
+}
+
+// LabelDescriptions returns true if the provider stores the
+// description of labels.
+func LabelDescriptions(driver scm.Driver) bool {
+	return driver != scm.DriverGogs
+}
+
+// SyncLabels makes the labels of the repository match the desired
+// labels, which are matched by name ignoring case. Existing labels
+// are updated if their name case, color or description differ, an
+// empty color or description being left unchanged. The description
+// is ignored if the provider does not store it. The labels which
+// are not desired are deleted if prune is true.
+func SyncLabels(ctx context.Context, client *scm.Client, repo string, desired []scm.Label, prune bool) (*SyncResult, error) {
+	result := &SyncResult{}
+	if CommentLabels(client.Driver) {
+		result.Comments = true
+		for i := range desired {
+			result.Skipped = append(result.Skipped, &desired[i])
+		}
+		return result, nil
+	}
+
+	existing, err := listLabels(ctx, client, repo)
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]*scm.Label{}
+	for _, label := range existing {
+		current[strings.ToLower(label.Name)] = label
+	}
+
+	descriptions := LabelDescriptions(client.Driver)
+	seen := map[string]bool{}
+	for i := range desired {
+		want := &desired[i]
+		key := strings.ToLower(want.Name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		input := &scm.LabelInput{
+			Name:        want.Name,
+			Color:       want.Color,
+			Description: want.Description,
+		}
+		got, ok := current[key]
+		switch {
+		case !ok:
+			label, _, err := client.Repositories.CreateLabel(ctx, repo, input)
+			if err != nil {
+				return result, fmt.Errorf("failed to create label %s: %w", want.Name, err)
+			}
+			result.Created = append(result.Created, label)
+		case labelChanged(got, want, descriptions):
+			label, _, err := client.Repositories.UpdateLabel(ctx, repo, got.Name, input)
+			if err != nil {
+				return result, fmt.Errorf("failed to update label %s: %w", got.Name, err)
+			}
+			result.Updated = append(result.Updated, label)
+		default:
+			result.Unchanged = append(result.Unchanged, got)
+		}
+	}
+
+	if !prune {
+		return result, nil
+	}
+	for _, label := range existing {
+		if seen[strings.ToLower(label.Name)] {
+			continue
+		}
+		if _, err := client.Repositories.DeleteLabel(ctx, repo, label.Name); err != nil {
+			return result, fmt.Errorf("failed to delete label %s: %w", label.Name, err)
+		}
+		result.Deleted = append(result.Deleted, label)
+	}
+	return result, nil
+}
+
+func listLabels(ctx context.Context, client *scm.Client, repo string) ([]*scm.Label, error) {
+	labels := []*scm.Label{}
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for {
+		out, res, err := client.Repositories.ListLabels(ctx, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels: %w", err)
+		}
+		labels = append(labels, out...)
+		if res == nil || res.Page.Next == 0 || res.Page.Next == opts.Page {
+			return labels, nil
+		}
+		opts.Page = res.Page.Next
+	}
+}
+
+func labelChanged(got, want *scm.Label, descriptions bool) bool {
+	if got.Name != want.Name {
+		return true
+	}
+	if want.Color != "" && !strings.EqualFold(strings.TrimPrefix(got.Color, "#"), strings.TrimPrefix(want.Color, "#")) {
+		return true
+	}
+	return descriptions && want.Description != "" && got.Description != want.Description
+}
@@ -0,0 +1,106 @@
+package labels
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"github.com/jenkins-x/go-scm/scm/driver/fake"
+)
+
+func labelNames(labels []*scm.Label) []string {
+	names := []string{}
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+func TestSyncLabels(t *testing.T) {
+	client, data := fake.NewDefault()
+	data.RepoLabelsExisting = []string{"bug", "Help", "stale"}
+
+	desired := []scm.Label{
+		{Name: "bug"},
+		{Name: "help"},
+		{Name: "feature", Color: "a2eeef"},
+	}
+	result, err := SyncLabels(context.Background(), client, "org/repo", desired, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := labelNames(result.Created), []string{"feature"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Created = %v, want %v", got, want)
+	}
+	if got, want := labelNames(result.Updated), []string{"help"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Updated = %v, want %v", got, want)
+	}
+	if got, want := labelNames(result.Deleted), []string{"stale"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Deleted = %v, want %v", got, want)
+	}
+	if got, want := labelNames(result.Unchanged), []string{"bug"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unchanged = %v, want %v", got, want)
+	}
+	if got, want := data.RepoLabelsExisting, []string{"bug", "help", "feature"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoLabelsExisting = %v, want %v", got, want)
+	}
+}
+
+func TestSyncLabelsNoPrune(t *testing.T) {
+	client, data := fake.NewDefault()
+	data.RepoLabelsExisting = []string{"bug", "stale"}
+
+	result, err := SyncLabels(context.Background(), client, "org/repo", []scm.Label{{Name: "bug"}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Deleted) != 0 {
+		t.Errorf("Deleted = %v, want none", labelNames(result.Deleted))
+	}
+	if got, want := data.RepoLabelsExisting, []string{"bug", "stale"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoLabelsExisting = %v, want %v", got, want)
+	}
+}
+
+func TestSyncLabelsComments(t *testing.T) {
+	client, data := fake.NewDefault()
+	client.Driver = scm.DriverStash
+	data.RepoLabelsExisting = []string{"stale"}
+
+	result, err := SyncLabels(context.Background(), client, "org/repo", []scm.Label{{Name: "bug"}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Comments {
+		t.Errorf("Comments = false, want true")
+	}
+	if got, want := labelNames(result.Skipped), []string{"bug"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Skipped = %v, want %v", got, want)
+	}
+	if len(result.Unchanged) != 0 {
+		t.Errorf("Unchanged = %v, want none", labelNames(result.Unchanged))
+	}
+	if got, want := data.RepoLabelsExisting, []string{"stale"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoLabelsExisting = %v, want %v", got, want)
+	}
+}
+
+func TestSyncLabelsNoDescriptions(t *testing.T) {
+	client, data := fake.NewDefault()
+	client.Driver = scm.DriverGogs
+	data.RepoLabelsExisting = []string{"bug"}
+
+	desired := []scm.Label{{Name: "bug", Description: "Something is broken"}}
+	result, err := SyncLabels(context.Background(), client, "org/repo", desired, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Updated) != 0 {
+		t.Errorf("Updated = %v, want none", labelNames(result.Updated))
+	}
+	if got, want := labelNames(result.Unchanged), []string{"bug"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unchanged = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// MergeQueueEntry represents a pull request in the merge
+	// queue of a branch, known as a merge train on GitLab.
+	MergeQueueEntry struct {
+		ID       string
+		Number   int    // pull request number
+		Position int    // position in the queue, starting at 1
+		State    string // provider specific, eg queued or merging
+		Sha      string // head commit of the entry
+		BaseRef  string // branch the queue merges into
+		Author   User   // user who added the pull request
+		Created  time.Time
+	}
+
+	// MergeQueueOptions provides options for adding a pull
+	// request to a merge queue.
+	MergeQueueOptions struct {
+		SHA  string // expected head sha of the pull request
+		Jump bool   // add to the front of the queue, GitHub only
+	}
+
+	// MergeQueueService provides access to the merge queues
+	// of a repository.
+	MergeQueueService interface {
+		// List returns the entries of the merge queue of the
+		// branch, in queue order.
+		List(ctx context.Context, repo, branch string, opts ListOptions) ([]*MergeQueueEntry, *Response, error)
+
+		// Add adds the pull request to the merge queue of its
+		// base branch.
+		Add(ctx context.Context, repo string, number int, opts *MergeQueueOptions) (*MergeQueueEntry, *Response, error)
+
+		// Remove removes the pull request from the merge queue.
+		Remove(ctx context.Context, repo string, number int) (*Response, error)
+	}
+)
@@ -14,6 +14,12 @@ type (
 	// MergeableState represents whether the PR can be merged
 	MergeableState string
 
+	// ReviewDecision represents the overall review state of a PR
+	ReviewDecision string
+
+	// ReviewerKind represents whether a PR reviewer is a user or a team
+	ReviewerKind string
+
 	// PullRequest represents a repository pull request.
 	PullRequest struct {
 		Number         int
@@ -35,6 +41,9 @@ type (
 		Rebaseable     bool
 		MergeableState MergeableState
 		MergeSha       string
+		MergedBy       *User
+		MergedAt       *time.Time
+		ClosedAt       *time.Time
 		Author         User
 		Assignees      []User
 		Reviewers      []User
@@ -42,6 +51,28 @@ type (
 		Created        time.Time
 		Updated        time.Time
 
+		// Commits is the number of commits, Additions, Deletions
+		// and ChangedFiles summarize the diff. They are zero when
+		// the provider does not report them.
+		Commits      int
+		Additions    int
+		Deletions    int
+		ChangedFiles int
+
+		// FromFork is true when the head branch belongs to a
+		// different repository than the base branch.
+		FromFork bool
+
+		// AutoMerge is set when the PR merges automatically once
+		// its requirements are met.
+		AutoMerge *AutoMerge
+
+		// ReviewDecision is empty when the provider does not
+		// report the review state. GitHub only reports it with
+		// the GraphQL API, which is not queried, so it is empty
+		// for GitHub pull requests.
+		ReviewDecision ReviewDecision
+
 		// Link links to the main pull request page
 		Link string
 
@@ -49,12 +80,61 @@ type (
 		DiffLink string
 	}
 
+	// AutoMerge represents the auto-merge settings of a PR.
+	AutoMerge struct {
+		EnabledBy     User
+		MergeMethod   string
+		CommitTitle   string
+		CommitMessage string
+	}
+
+	// Reviewer represents a user, or team, requested to review
+	// a pull request.
+	Reviewer struct {
+		Kind ReviewerKind
+		User User // set for user reviewers
+		Team Team // set for team reviewers
+
+		// State is the state of the latest review, or
+		// ReviewStatePending if there is none yet.
+		State string
+
+		// Reviewed is the time of the latest review.
+		Reviewed time.Time
+	}
+
 	// PullRequestInput provides the input needed to create or update a PR.
+	// Update only changes the fields which are set.
 	PullRequestInput struct {
 		Title string
-		Head  string
+		Head  string // branch, or owner:branch for the branch of a fork
 		Base  string
 		Body  string
+
+		// Draft creates the pull request as a draft. It is
+		// ignored by Update, use SetDraft instead.
+		Draft bool
+
+		Labels    []string
+		Assignees []string // user logins
+		Reviewers []string // user logins, or org/team on GitHub
+		Milestone int      // milestone number
+
+		// On Update the labels and assignees replace the existing
+		// ones, while the reviewers are added to those already
+		// requested. Gitea requests reviews by assigning the pull
+		// request, so there the reviewers are added to the given
+		// assignees, or to the existing ones if none are given.
+
+		// MaintainerCanModify allows the maintainers of the base
+		// repository to push to the head branch of a fork.
+		MaintainerCanModify *bool
+
+		// Squash and RemoveSourceBranch set the merge options of
+		// the pull request on GitLab. Bitbucket Cloud supports
+		// RemoveSourceBranch only.
+		Squash             *bool
+		RemoveSourceBranch *bool
 	}
 
 	// Milestone the milestone
@@ -119,6 +199,26 @@ type (
 		DeleteSourceBranch bool
 	}
 
+	// AutoMergeOptions lets you define how a pull request is merged
+	// automatically once its requirements are met.
+	AutoMergeOptions struct {
+		// The merge method to use. Possible values include: "merge", "squash", and "rebase" with the default being merge. (Optional.)
+		MergeMethod string
+
+		CommitTitle   string // Title of the merge commit. (Optional.)
+		CommitMessage string // Body of the merge commit. (Optional.)
+		SHA           string // SHA that pull request head must match to allow merge. (Optional.)
+	}
+
+	// PullRequestUpdateBranchOptions lets you define how a pull request
+	// branch is updated with the changes of its base branch.
+	PullRequestUpdateBranchOptions struct {
+		// The update method to use. Possible values include: "merge" and "rebase" with the default being merge. (Optional.)
+		Method string
+
+		SHA string // SHA that pull request head must match to allow the update. (Optional.)
+	}
+
 	// PullRequestService provides access to pull request resources.
 	PullRequestService interface {
 		// Find returns the repository pull request by number.
@@ -133,6 +233,9 @@ type (
 		// Find returns the repository pull request list.
 		List(context.Context, string, PullRequestListOptions) ([]*PullRequest, *Response, error)
 
+		// ListCommits returns the commits of the pull request.
+		ListCommits(ctx context.Context, repo string, number int, opts ListOptions) ([]*Commit, *Response, error)
+
 		// ListChanges returns the pull request changeset.
 		ListChanges(context.Context, string, int, ListOptions) ([]*Change, *Response, error)
 
@@ -148,6 +251,18 @@ type (
 		// Merge merges the repository pull request.
 		Merge(context.Context, string, int, *PullRequestMergeOptions) (*Response, error)
 
+		// EnableAutoMerge merges the pull request automatically
+		// once the required checks and reviews pass.
+		EnableAutoMerge(ctx context.Context, repo string, number int, opts *AutoMergeOptions) (*Response, error)
+
+		// DisableAutoMerge cancels the automatic merge of the pull
+		// request.
+		DisableAutoMerge(ctx context.Context, repo string, number int) (*Response, error)
+
+		// SetDraft converts the pull request to a draft, or marks
+		// it as ready for review.
+		SetDraft(ctx context.Context, repo string, number int, draft bool) (*Response, error)
+
 		// Close closes the repository pull request.
 		Close(context.Context, string, int) (*Response, error)
 
@@ -184,11 +299,34 @@ type (
 		// UnrequestReview removes one or more users as a reviewer on a pull request.
 		UnrequestReview(ctx context.Context, repo string, number int, logins []string) (*Response, error)
 
+		// RequestTeamReview adds one or more teams, by slug, as a
+		// reviewer on a pull request.
+		RequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*Response, error)
+
+		// UnrequestTeamReview removes one or more teams, by slug, as
+		// a reviewer on a pull request.
+		UnrequestTeamReview(ctx context.Context, repo string, number int, teams []string) (*Response, error)
+
+		// ListReviewers returns the users and teams requested to
+		// review the pull request, with their review state.
+		ListReviewers(ctx context.Context, repo string, number int) ([]*Reviewer, *Response, error)
+
 		// SetMilestone adds a milestone to a pull request
 		SetMilestone(ctx context.Context, repo string, prID int, number int) (*Response, error)
 
 		// ClearMilestone removes the milestone from a pull request
 		ClearMilestone(ctx context.Context, repo string, prID int) (*Response, error)
+
+		// UpdatePullRequestBranch updates the pull request branch with
+		// the changes of the base branch, returning a MergeConflict
+		// error if the update has conflicts, or ErrRefMismatch if
+		// the head does not match the expected SHA.
+		UpdatePullRequestBranch(ctx context.Context, repo string, number int, opts *PullRequestUpdateBranchOptions) (*Response, error)
+
+		// RebasePullRequest rebases the pull request branch onto the
+		// base branch, returning a MergeConflict error if the rebase
+		// has conflicts.
+		RebasePullRequest(ctx context.Context, repo string, number int) (*Response, error)
 	}
 )
 
@@ -202,6 +340,26 @@ const (
 	MergeableStateUnknown MergeableState = ""
 )
 
+// ReviewDecision values.
+const (
+	// ReviewDecisionApproved The pull request has the required approvals.
+	ReviewDecisionApproved ReviewDecision = "approved"
+	// ReviewDecisionChangesRequested A reviewer requested changes.
+	ReviewDecisionChangesRequested ReviewDecision = "changes_requested"
+	// ReviewDecisionReviewRequired The pull request still needs approvals.
+	ReviewDecisionReviewRequired ReviewDecision = "review_required"
+	// ReviewDecisionUnknown The review state is not reported.
+	ReviewDecisionUnknown ReviewDecision = ""
+)
+
+// ReviewerKind values.
+const (
+	// ReviewerKindUser The reviewer is a user.
+	ReviewerKindUser ReviewerKind = "user"
+	// ReviewerKindTeam The reviewer is a team.
+	ReviewerKindTeam ReviewerKind = "team"
+)
+
 // Repository returns the base repository where the PR will merge to
 func (pr *PullRequest) Repository() Repository {
 	return pr.Base.Repo
@@ -223,3 +381,8 @@ func ToMergeableState(text string) MergeableState {
 func (s MergeableState) String() string {
 	return string(s)
 }
+
+// String returns the string representation
+func (d ReviewDecision) String() string {
+	return string(d)
+}
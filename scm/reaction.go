@@ -0,0 +1,167 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Emoji represents a normalized reaction emoji.
+type Emoji int
+
+// Emoji values.
+const (
+	EmojiUnknown Emoji = iota
+	EmojiThumbsUp
+	EmojiThumbsDown
+	EmojiLaugh
+	EmojiConfused
+	EmojiHeart
+	EmojiHooray
+	EmojiRocket
+	EmojiEyes
+)
+
+// String returns the string representation of the Emoji, which is
+// the name used by GitHub and Gitea.
+func (e Emoji) String() string {
+	switch e {
+	case EmojiThumbsUp:
+		return "+1"
+	case EmojiThumbsDown:
+		return "-1"
+	case EmojiLaugh:
+		return "laugh"
+	case EmojiConfused:
+		return "confused"
+	case EmojiHeart:
+		return "heart"
+	case EmojiHooray:
+		return "hooray"
+	case EmojiRocket:
+		return "rocket"
+	case EmojiEyes:
+		return "eyes"
+	default:
+		return "unknown"
+	}
+}
+
+// Shortcode returns the shortcode of the Emoji, which is the name
+// used by GitLab and Bitbucket.
+func (e Emoji) Shortcode() string {
+	switch e {
+	case EmojiThumbsUp:
+		return "thumbsup"
+	case EmojiThumbsDown:
+		return "thumbsdown"
+	case EmojiLaugh:
+		return "laughing"
+	case EmojiHooray:
+		return "tada"
+	default:
+		return e.String()
+	}
+}
+
+// MarshalJSON marshals Emoji to JSON.
+func (e Emoji) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON unmarshals the JSON-encoded Emoji.
+func (e *Emoji) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*e = ToEmoji(s)
+	return nil
+}
+
+// ToEmoji converts the name or the shortcode of an emoji to the
+// normalized Emoji.
+func ToEmoji(s string) Emoji {
+	switch strings.ToLower(strings.Trim(s, ":")) {
+	case "+1", "thumbsup", "thumbs_up", "like", "👍":
+		return EmojiThumbsUp
+	case "-1", "thumbsdown", "thumbs_down", "👎":
+		return EmojiThumbsDown
+	case "laugh", "laughing", "smile", "😄":
+		return EmojiLaugh
+	case "confused", "😕":
+		return EmojiConfused
+	case "heart", "❤️":
+		return EmojiHeart
+	case "hooray", "tada", "🎉":
+		return EmojiHooray
+	case "rocket", "🚀":
+		return EmojiRocket
+	case "eyes", "👀":
+		return EmojiEyes
+	default:
+		return EmojiUnknown
+	}
+}
+
+type (
+	// Reaction represents an emoji reaction to an issue, a pull
+	// request or a comment.
+	Reaction struct {
+		ID      int
+		Emoji   Emoji
+		Content string // provider specific name of the emoji
+		User    User
+		Created time.Time
+	}
+
+	// ReactionService provides access to reactions. The comments
+	// are the comments of the issue or pull request conversation.
+	// The reactions are deleted by emoji, for the authenticated
+	// user.
+	ReactionService interface {
+		// ListIssueReactions returns the reactions to an issue.
+		ListIssueReactions(ctx context.Context, repo string, number int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateIssueReaction reacts to an issue.
+		CreateIssueReaction(ctx context.Context, repo string, number int, emoji Emoji) (*Reaction, *Response, error)
+
+		// DeleteIssueReaction deletes a reaction to an issue.
+		DeleteIssueReaction(ctx context.Context, repo string, number int, emoji Emoji) (*Response, error)
+
+		// ListIssueCommentReactions returns the reactions to an
+		// issue comment.
+		ListIssueCommentReactions(ctx context.Context, repo string, number, id int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreateIssueCommentReaction reacts to an issue comment.
+		CreateIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji Emoji) (*Reaction, *Response, error)
+
+		// DeleteIssueCommentReaction deletes a reaction to an
+		// issue comment.
+		DeleteIssueCommentReaction(ctx context.Context, repo string, number, id int, emoji Emoji) (*Response, error)
+
+		// ListPullRequestReactions returns the reactions to a
+		// pull request.
+		ListPullRequestReactions(ctx context.Context, repo string, number int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreatePullRequestReaction reacts to a pull request.
+		CreatePullRequestReaction(ctx context.Context, repo string, number int, emoji Emoji) (*Reaction, *Response, error)
+
+		// DeletePullRequestReaction deletes a reaction to a pull
+		// request.
+		DeletePullRequestReaction(ctx context.Context, repo string, number int, emoji Emoji) (*Response, error)
+
+		// ListPullRequestCommentReactions returns the reactions
+		// to a pull request comment.
+		ListPullRequestCommentReactions(ctx context.Context, repo string, number, id int, opts ListOptions) ([]*Reaction, *Response, error)
+
+		// CreatePullRequestCommentReaction reacts to a pull
+		// request comment.
+		CreatePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji Emoji) (*Reaction, *Response, error)
+
+		// DeletePullRequestCommentReaction deletes a reaction to
+		// a pull request comment.
+		DeletePullRequestCommentReaction(ctx context.Context, repo string, number, id int, emoji Emoji) (*Response, error)
+	}
+)
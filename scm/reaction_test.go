@@ -0,0 +1,54 @@
+package scm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEmojiJSON(t *testing.T) {
+	for i := EmojiUnknown; i <= EmojiEyes; i++ {
+		in := Emoji(i)
+		t.Run(in.String(), func(t *testing.T) {
+			b, err := json.Marshal(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var out Emoji
+			if err := json.Unmarshal(b, &out); err != nil {
+				t.Fatal(err)
+			}
+
+			if in != out {
+				t.Errorf("%s != %s", in, out)
+			}
+		})
+	}
+}
+
+func TestToEmoji(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Emoji
+	}{
+		{"+1", EmojiThumbsUp},
+		{"thumbsup", EmojiThumbsUp},
+		{":thumbsdown:", EmojiThumbsDown},
+		{"laughing", EmojiLaugh},
+		{"tada", EmojiHooray},
+		{"HEART", EmojiHeart},
+		{"🚀", EmojiRocket},
+		{"eyes", EmojiEyes},
+		{"unicorn", EmojiUnknown},
+	}
+	for _, test := range tests {
+		if got := ToEmoji(test.in); got != test.want {
+			t.Errorf("ToEmoji(%q) = %s, want %s", test.in, got, test.want)
+		}
+	}
+	for i := EmojiThumbsUp; i <= EmojiEyes; i++ {
+		if got := ToEmoji(i.Shortcode()); got != i {
+			t.Errorf("ToEmoji(%q) = %s, want %s", i.Shortcode(), got, i)
+		}
+	}
+}
@@ -48,6 +48,14 @@ type (
 		Private     bool
 	}
 
+	// LabelInput provides the input fields required for
+	// creating or updating a repository label.
+	LabelInput struct {
+		Name        string
+		Color       string // hex color code, with or without a leading #
+		Description string
+	}
+
 	// Perm represents a user's repository permissions.
 	Perm struct {
 		Pull  bool
@@ -145,6 +153,18 @@ type (
 		// ListLabels returns the labels on a repo
 		ListLabels(context.Context, string, ListOptions) ([]*Label, *Response, error)
 
+		// CreateLabel creates a repository label.
+		CreateLabel(ctx context.Context, repo string, input *LabelInput) (*Label, *Response, error)
+
+		// UpdateLabel updates the repository label with the given
+		// name, renaming it if the input name is different. Empty
+		// input fields are left unchanged.
+		UpdateLabel(ctx context.Context, repo, name string, input *LabelInput) (*Label, *Response, error)
+
+		// DeleteLabel deletes the repository label with the given
+		// name.
+		DeleteLabel(ctx context.Context, repo, name string) (*Response, error)
+
 		// ListHooks returns a list or repository hooks.
 		ListHooks(context.Context, string, ListOptions) ([]*Hook, *Response, error)
 
@@ -186,6 +206,9 @@ type (
 
 		// Delete deletes a repository
 		Delete(ctx context.Context, repo string) (*Response, error)
+
+		// SetDefaultBranch sets the default branch of the repository.
+		SetDefaultBranch(ctx context.Context, repo, branch string) (*Response, error)
 	}
 )
 
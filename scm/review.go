@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
@@ -22,17 +23,31 @@ type (
 		Updated time.Time
 	}
 
-	// ReviewComment represents a review comment.
+	// ReviewComment represents a review comment. On GitHub the
+	// Line of a single-line comment is the position in the diff,
+	// for compatibility.
 	ReviewComment struct {
-		ID      int
-		Body    string
-		Path    string
-		Sha     string
-		Line    int
-		Link    string
-		Author  User
-		Created time.Time
-		Updated time.Time
+		ID        int
+		Body      string
+		Path      string
+		Sha       string
+		Line      int    // line of the file, or last line of a range
+		StartLine int    // first line of a multi-line comment
+		Side      string // side of the diff, LEFT or RIGHT
+		StartSide string
+		Position  int // position in the diff, GitHub only
+		Link      string
+		Author    User
+		Created   time.Time
+		Updated   time.Time
+
+		// InReplyTo is the ID of the comment replied to.
+		InReplyTo int
+
+		// ThreadID identifies the thread, or discussion, of the
+		// comment and is used to resolve it.
+		ThreadID string
+		Resolved bool
 	}
 
 	// ReviewHook represents a review web hook
@@ -47,11 +62,21 @@ type (
 	}
 
 	// ReviewCommentInput provides the input fields required for
-	// creating a review comment.
+	// creating a review comment. On GitHub the Line is sent as the
+	// position in the diff unless Side or StartLine is set, for
+	// compatibility.
 	ReviewCommentInput struct {
-		Body string
-		Path string
-		Line int
+		Body      string
+		Path      string
+		Line      int    // line of the file, or last line of a range
+		StartLine int    // first line of a multi-line comment
+		Side      string // side of the diff, LEFT or RIGHT (default)
+		StartSide string
+		Position  int // position in the diff, GitHub only
+
+		// Suggestion is the suggested replacement of the
+		// commented lines.
+		Suggestion string
 	}
 
 	// ReviewSubmitInput provides the input fields required for submitting a pending review.
@@ -93,9 +118,27 @@ type (
 
 		// Dismiss dismisses a review
 		Dismiss(context.Context, string, int, int, string) (*Review, *Response, error)
+
+		// CreateComment creates a review comment on the diff,
+		// starting a new thread.
+		CreateComment(context.Context, string, int, *ReviewCommentInput) (*ReviewComment, *Response, error)
+
+		// ReplyToComment replies to a review comment.
+		ReplyToComment(context.Context, string, int, int, string) (*ReviewComment, *Response, error)
+
+		// ResolveThread resolves a review comment thread.
+		ResolveThread(context.Context, string, int, string) (*Response, error)
 	}
 )
 
+// Review comment sides.
+const (
+	// SideLeft is the side of the deleted lines of the diff.
+	SideLeft = "LEFT"
+	// SideRight is the side of the added and unchanged lines of the diff.
+	SideRight = "RIGHT"
+)
+
 const (
 	// ReviewStateApproved is used for approved reviews
 	ReviewStateApproved string = "APPROVED"
@@ -108,3 +151,17 @@ const (
 	// ReviewStatePending is used for reviews that are awaiting response
 	ReviewStatePending string = "PENDING"
 )
+
+// FormatSuggestion appends the suggested change to the body of a
+// review comment as a fenced suggestion block. The info string of
+// the fence is provider specific, GitLab uses it to span several
+// lines.
+func FormatSuggestion(body, suggestion, info string) string {
+	if suggestion == "" {
+		return body
+	}
+	if body != "" {
+		body += "\n\n"
+	}
+	return body + "```" + info + "\n" + strings.TrimSuffix(suggestion, "\n") + "\n```"
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "context"
+
+type (
+	// SearchQueryOptions provides the options for a search. The
+	// search is restricted to the repository or the organization,
+	// if set, and some providers require one of them.
+	SearchQueryOptions struct {
+		Query     string // search terms
+		Repo      string // repository full name
+		Org       string // organization, group or workspace
+		Sort      string // provider specific sort field
+		Ascending bool
+		Page      int
+		Size      int
+	}
+
+	// SearchCode represents a file matching a code search.
+	SearchCode struct {
+		Repository Repository
+		Path       string
+		Sha        string // blob or commit sha, if known
+		Ref        string // branch or tag, if known
+		Link       string
+		Fragments  []*SearchFragment
+	}
+
+	// SearchFragment represents a text fragment of a file
+	// matching a code search.
+	SearchFragment struct {
+		Line int // line number of the first line, or 0 if unknown
+		Text string
+	}
+
+	// SearchCommit represents a commit matching a search.
+	SearchCommit struct {
+		Commit
+		Repository Repository
+	}
+
+	// SearchService provides access to search across the
+	// repositories of a provider. The results are paginated
+	// through the Response.
+	SearchService interface {
+		// Code searches the content and paths of files.
+		Code(ctx context.Context, opts SearchQueryOptions) ([]*SearchCode, *Response, error)
+
+		// Commits searches commit messages.
+		Commits(ctx context.Context, opts SearchQueryOptions) ([]*SearchCommit, *Response, error)
+
+		// Repositories searches repositories.
+		Repositories(ctx context.Context, opts SearchQueryOptions) ([]*Repository, *Response, error)
+
+		// PullRequests searches pull requests. The base repository
+		// of the pull requests is always set, other fields depend
+		// on the provider.
+		PullRequests(ctx context.Context, opts SearchQueryOptions) ([]*PullRequest, *Response, error)
+	}
+)
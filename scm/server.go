@@ -0,0 +1,80 @@
+package scm
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Edition values.
+const (
+	EditionCommunity  = "community"
+	EditionEnterprise = "enterprise"
+	EditionCloud      = "cloud"
+)
+
+type (
+	// ServerInfo describes the product, version and edition
+	// of the git server a client communicates with.
+	ServerInfo struct {
+		Product string
+		Version string
+		Edition string
+	}
+
+	// ServerService provides access to git server metadata.
+	ServerService interface {
+		// Find returns the server product, version and edition.
+		Find(context.Context) (*ServerInfo, *Response, error)
+	}
+)
+
+// AtLeast returns true if the server version is greater than
+// or equal to the given version. Pre-release and build suffixes
+// such as "-ee" or "+dev" are ignored. An unknown server version
+// is never considered to satisfy the constraint.
+func (s *ServerInfo) AtLeast(version string) bool {
+	if s == nil || s.Version == "" {
+		return false
+	}
+	return CompareVersions(s.Version, version) >= 0
+}
+
+// CompareVersions compares two dotted version strings, returning
+// -1 if a is older than b, 0 if they are equal and +1 if a is
+// newer than b. A leading "v" and any pre-release or build suffix
+// are ignored and missing segments are treated as zero.
+func CompareVersions(a, b string) int {
+	as, bs := versionSegments(a), versionSegments(b)
+	for len(as) < len(bs) {
+		as = append(as, 0)
+	}
+	for len(bs) < len(as) {
+		bs = append(bs, 0)
+	}
+	for i := range as {
+		switch {
+		case as[i] < bs[i]:
+			return -1
+		case as[i] > bs[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionSegments(v string) []int {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+ "); i != -1 {
+		v = v[:i]
+	}
+	var segments []int
+	for _, s := range strings.Split(v, ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			break
+		}
+		segments = append(segments, n)
+	}
+	return segments
+}
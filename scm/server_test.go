@@ -0,0 +1,132 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.12.4", "1.12.4", 0},
+		{"1.12", "1.12.0", 0},
+		{"v1.14.0", "1.14", 0},
+		{"1.12.4", "1.14.0", -1},
+		{"1.14.1+dev-12-g1234", "1.14.0", 1},
+		{"13.0.0-ee", "13.0.0", 0},
+		{"15.6.9", "15.7", -1},
+		{"16.0.0-pre", "15.7", 1},
+	}
+	for _, test := range tests {
+		if got := CompareVersions(test.a, test.b); got != test.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestServerInfoAtLeast(t *testing.T) {
+	info := &ServerInfo{Product: "gitea", Version: "1.14.2"}
+	if !info.AtLeast("1.14") {
+		t.Errorf("Expect version 1.14.2 to be at least 1.14")
+	}
+	if info.AtLeast("1.15") {
+		t.Errorf("Expect version 1.14.2 to be older than 1.15")
+	}
+	if (&ServerInfo{Product: "gogs"}).AtLeast("0.1") {
+		t.Errorf("Expect unknown version to never satisfy a constraint")
+	}
+}
+
+func TestClientServerInfo(t *testing.T) {
+	server := &mockServerService{info: &ServerInfo{Product: "gitlab", Version: "13.0.0-ee", Edition: EditionEnterprise}}
+	client := &Client{Server: server}
+	for i := 0; i < 2; i++ {
+		info, err := client.ServerInfo(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := info.Product, "gitlab"; got != want {
+			t.Errorf("Want product %s, got %s", want, got)
+		}
+	}
+	if got, want := server.calls, 1; got != want {
+		t.Errorf("Want server info to be fetched %d time(s), got %d", want, got)
+	}
+
+	_, err := new(Client).ServerInfo(context.Background())
+	if err != ErrNotSupported {
+		t.Errorf("Expect Not Supported error without a server service")
+	}
+}
+
+func TestClientServerInfoError(t *testing.T) {
+	server := &mockServerService{err: ErrNotFound}
+	client := &Client{Server: server}
+	for i := 0; i < 2; i++ {
+		if _, err := client.ServerInfo(context.Background()); err != ErrNotFound {
+			t.Errorf("Want error %v, got %v", ErrNotFound, err)
+		}
+	}
+	if got, want := server.calls, 1; got != want {
+		t.Errorf("Want server info to be fetched %d time(s), got %d", want, got)
+	}
+
+	// resetting the server info retries the detection.
+	client.SetServerInfo(nil)
+	client.ServerInfo(context.Background())
+	if got, want := server.calls, 2; got != want {
+		t.Errorf("Want server info to be fetched %d time(s), got %d", want, got)
+	}
+}
+
+func TestClientServerInfoTransientError(t *testing.T) {
+	server := &mockServerService{err: context.DeadlineExceeded}
+	client := &Client{Server: server}
+	for i := 0; i < 2; i++ {
+		if _, err := client.ServerInfo(context.Background()); err != context.DeadlineExceeded {
+			t.Errorf("Want error %v, got %v", context.DeadlineExceeded, err)
+		}
+	}
+	if got, want := server.calls, 2; got != want {
+		t.Errorf("Want server info to be fetched %d time(s), got %d", want, got)
+	}
+
+	// a server error is retried as well.
+	server.err = errors.New("Internal Server Error")
+	server.res = &Response{Status: 500}
+	client.ServerInfo(context.Background())
+	server.err, server.res = nil, nil
+	server.info = &ServerInfo{Product: "stash", Version: "8.15.0"}
+	info, err := client.ServerInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := info.Version, "8.15.0"; got != want {
+		t.Errorf("Want version %s, got %s", want, got)
+	}
+
+	// a missing endpoint is a definitive answer.
+	client.SetServerInfo(nil)
+	server.info, server.err, server.res = nil, errors.New("Not Found"), &Response{Status: 404}
+	client.ServerInfo(context.Background())
+	calls := server.calls
+	client.ServerInfo(context.Background())
+	if server.calls != calls {
+		t.Errorf("Want a not found server info to be cached")
+	}
+}
+
+type mockServerService struct {
+	info  *ServerInfo
+	res   *Response
+	err   error
+	calls int
+}
+
+func (s *mockServerService) Find(context.Context) (*ServerInfo, *Response, error) {
+	s.calls++
+	return s.info, s.res, s.err
+}
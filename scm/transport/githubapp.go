@@ -0,0 +1,280 @@
+package transport
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+const (
+	// jwtLifetime is the lifetime of a GitHub App JWT. GitHub
+	// rejects tokens which expire more than 10 minutes ahead.
+	jwtLifetime = 9 * time.Minute
+
+	// jwtClockSkew backdates the JWT issue time to allow for
+	// clock drift between the client and GitHub.
+	jwtClockSkew = time.Minute
+
+	// installationTokenExpiryDelta determines how much earlier
+	// an installation token is refreshed than its expiry time.
+	installationTokenExpiryDelta = 5 * time.Minute
+)
+
+// ErrMissingInstallation is returned when a GitHub App
+// transport has neither an installation nor a repository
+// to resolve the installation from.
+var ErrMissingInstallation = errors.New("no GitHub App installation or repository specified")
+
+// ParseGitHubAppKey parses a PEM encoded RSA private key as
+// downloaded from the GitHub App settings page.
+func ParseGitHubAppKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found in GitHub App private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub App private key: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// GitHubAppJWT is an http.RoundTripper that makes HTTP
+// requests, wrapping a base RoundTripper and adding an
+// Authorization header with a JWT signed by the GitHub App
+// private key. It is used to call the app endpoints, such as
+// creating installation tokens.
+type GitHubAppJWT struct {
+	Base http.RoundTripper
+
+	AppID      int64           // GitHub App identifier
+	PrivateKey *rsa.PrivateKey // GitHub App private key
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// RoundTrip adds the Authorization header to the request.
+func (t *GitHubAppJWT) RoundTrip(r *http.Request) (*http.Response, error) {
+	// Do not overwrite the authorization header if exists.
+	if r.Header.Get("Authorization") != "" {
+		return t.base().RoundTrip(r)
+	}
+	token, err := t.Token()
+	if err != nil {
+		return nil, err
+	}
+	r2 := cloneRequest(r)
+	r2.Header.Set("Authorization", "Bearer "+token)
+	return t.base().RoundTrip(r2)
+}
+
+// Token returns a signed JWT for the GitHub App. The JWT is
+// reused until shortly before it expires.
+func (t *GitHubAppJWT) Token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	if t.token != "" && now.Add(jwtClockSkew).Before(t.expires) {
+		return t.token, nil
+	}
+	expires := now.Add(jwtLifetime)
+	token, err := signGitHubAppJWT(t.AppID, t.PrivateKey, now.Add(-jwtClockSkew), expires)
+	if err != nil {
+		return "", err
+	}
+	t.token, t.expires = token, expires
+	return token, nil
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *GitHubAppJWT) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// GitHubAppTokens fetches and caches the installation tokens
+// of a GitHub App, refreshing each token before it expires.
+// The Apps service must be authenticated with a GitHubAppJWT
+// transport. It is safe for concurrent use by multiple
+// goroutines and can be shared by many GitHubApp transports.
+type GitHubAppTokens struct {
+	Apps scm.AppService
+
+	mu            sync.Mutex
+	tokens        map[int64]*installationToken
+	installations map[string]int64
+}
+
+// installationToken is the cached token of an installation. Its
+// lock is held while the token is refreshed, so that refreshing
+// one installation does not block the others.
+type installationToken struct {
+	mu    sync.Mutex
+	token *scm.InstallationToken
+}
+
+// Token returns a valid token for the installation, creating a
+// new one if there is no cached token or it is about to expire.
+func (t *GitHubAppTokens) Token(ctx context.Context, installationID int64) (*scm.InstallationToken, error) {
+	t.mu.Lock()
+	if t.tokens == nil {
+		t.tokens = map[int64]*installationToken{}
+	}
+	cached, ok := t.tokens[installationID]
+	if !ok {
+		cached = new(installationToken)
+		t.tokens[installationID] = cached
+	}
+	t.mu.Unlock()
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+	if cached.token != nil && !installationTokenExpired(cached.token) {
+		return cached.token, nil
+	}
+	token, _, err := t.Apps.CreateInstallationToken(ctx, installationID)
+	if err != nil {
+		return nil, err
+	}
+	cached.token = token
+	return token, nil
+}
+
+// InstallationID returns the installation of the GitHub App for
+// the given repository. The result is cached.
+func (t *GitHubAppTokens) InstallationID(ctx context.Context, repo string) (int64, error) {
+	t.mu.Lock()
+	id, ok := t.installations[repo]
+	t.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	installation, _, err := t.Apps.GetRepositoryInstallation(ctx, repo)
+	if err != nil {
+		return 0, err
+	}
+	t.mu.Lock()
+	if t.installations == nil {
+		t.installations = map[string]int64{}
+	}
+	t.installations[repo] = installation.ID
+	t.mu.Unlock()
+	return installation.ID, nil
+}
+
+// GitHubApp is an http.RoundTripper that makes HTTP requests,
+// wrapping a base RoundTripper and adding an Authorization
+// header with an installation token of a GitHub App. If no
+// installation is configured, it is resolved from the
+// repository.
+type GitHubApp struct {
+	Base http.RoundTripper
+
+	Tokens         *GitHubAppTokens
+	InstallationID int64
+	Repository     string
+}
+
+// RoundTrip adds the Authorization header to the request.
+func (t *GitHubApp) RoundTrip(r *http.Request) (*http.Response, error) {
+	// Do not overwrite the authorization header if exists.
+	if r.Header.Get("Authorization") != "" {
+		return t.base().RoundTrip(r)
+	}
+	ctx := r.Context()
+	id := t.InstallationID
+	if id == 0 {
+		if t.Repository == "" {
+			return nil, ErrMissingInstallation
+		}
+		var err error
+		id, err = t.Tokens.InstallationID(ctx, t.Repository)
+		if err != nil {
+			return nil, err
+		}
+	}
+	token, err := t.Tokens.Token(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r2 := cloneRequest(r)
+	r2.Header.Set("Authorization", "token "+token.Token)
+	return t.base().RoundTrip(r2)
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *GitHubApp) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// installationTokenExpired reports whether the token is
+// expired or about to expire.
+func installationTokenExpired(token *scm.InstallationToken) bool {
+	if token.ExpiresAt == nil {
+		return false
+	}
+	return token.ExpiresAt.Add(-installationTokenExpiryDelta).
+		Before(time.Now())
+}
+
+// signGitHubAppJWT creates a RS256 JWT identifying the app.
+//
+// See https://docs.github.com/en/developers/apps/authenticating-with-github-apps#authenticating-as-a-github-app
+func signGitHubAppJWT(appID int64, key *rsa.PrivateKey, issued, expires time.Time) (string, error) {
+	if key == nil {
+		return "", errors.New("no GitHub App private key specified")
+	}
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": issued.Unix(),
+		"exp": expires.Unix(),
+		"iss": strconv.FormatInt(appID, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
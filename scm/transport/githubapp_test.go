@@ -0,0 +1,238 @@
+package transport
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestParseGitHubAppKey(t *testing.T) {
+	key := generateKey(t)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	raw, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: raw})
+
+	for _, data := range [][]byte{pkcs1, pkcs8} {
+		got, err := ParseGitHubAppKey(data)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got.N.Cmp(key.N) != 0 || got.D.Cmp(key.D) != 0 {
+			t.Errorf("Want parsed key to match the original key")
+		}
+	}
+
+	if _, err := ParseGitHubAppKey([]byte("not a key")); err == nil {
+		t.Errorf("Expect error parsing invalid key")
+	}
+}
+
+func TestGitHubAppJWT(t *testing.T) {
+	defer gock.Off()
+
+	key := generateKey(t)
+	jwt := &GitHubAppJWT{AppID: 42, PrivateKey: key}
+	token, err := jwt.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Want JWT with 3 segments, got %d", len(parts))
+	}
+	sig, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, sum[:], sig); err != nil {
+		t.Errorf("Invalid JWT signature: %v", err)
+	}
+
+	claims := map[string]interface{}{}
+	raw, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	json.Unmarshal(raw, &claims)
+	if got, want := claims["iss"], "42"; got != want {
+		t.Errorf("Want issuer %v, got %v", want, got)
+	}
+	issued, expires := int64(claims["iat"].(float64)), int64(claims["exp"].(float64))
+	if lifetime := time.Duration(expires-issued) * time.Second; lifetime > 10*time.Minute {
+		t.Errorf("Want JWT lifetime of at most 10 minutes, got %s", lifetime)
+	}
+
+	again, _ := jwt.Token()
+	if again != token {
+		t.Errorf("Want JWT to be reused until it expires")
+	}
+
+	gock.New("https://api.github.com").
+		Get("/app").
+		MatchHeader("Authorization", "Bearer "+token).
+		Reply(200)
+
+	client := &http.Client{Transport: jwt}
+	res, err := client.Get("https://api.github.com/app")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer res.Body.Close()
+}
+
+func TestGitHubApp(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world").
+		MatchHeader("Authorization", "token v1.installation1").
+		Times(2).
+		Reply(200)
+
+	apps := &mockAppService{installationID: 1}
+	client := &http.Client{
+		Transport: &GitHubApp{
+			Tokens:     &GitHubAppTokens{Apps: apps},
+			Repository: "octocat/hello-world",
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get("https://api.github.com/repos/octocat/hello-world")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+	if got, want := apps.created, 1; got != want {
+		t.Errorf("Want %d installation token(s) created, got %d", want, got)
+	}
+	if got, want := apps.lookups, 1; got != want {
+		t.Errorf("Want %d installation lookup(s), got %d", want, got)
+	}
+}
+
+func TestGitHubAppTokens_Refresh(t *testing.T) {
+	apps := &mockAppService{installationID: 1, expiresIn: 2 * time.Minute}
+	tokens := &GitHubAppTokens{Apps: apps}
+
+	first, err := tokens.Token(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := tokens.Token(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Token == second.Token {
+		t.Errorf("Want token about to expire to be refreshed")
+	}
+}
+
+func TestGitHubAppTokens_Concurrent(t *testing.T) {
+	apps := &blockingAppService{blocked: 1, release: make(chan struct{})}
+	tokens := &GitHubAppTokens{Apps: apps}
+
+	done := make(chan struct{})
+	go func() {
+		tokens.Token(context.Background(), 1)
+		close(done)
+	}()
+
+	// the refresh of installation 1 must not block installation 2.
+	result := make(chan error)
+	go func() {
+		_, err := tokens.Token(context.Background(), 2)
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Want token of installation 2 while installation 1 refreshes")
+	}
+	close(apps.release)
+	<-done
+}
+
+func TestGitHubApp_MissingInstallation(t *testing.T) {
+	client := &http.Client{
+		Transport: &GitHubApp{Tokens: &GitHubAppTokens{Apps: &mockAppService{}}},
+	}
+	_, err := client.Get("https://api.github.com/user")
+	if err == nil {
+		t.Errorf("Expect error without an installation or repository")
+	}
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+type mockAppService struct {
+	installationID int64
+	expiresIn      time.Duration
+	created        int
+	lookups        int
+}
+
+func (s *mockAppService) CreateInstallationToken(ctx context.Context, id int64) (*scm.InstallationToken, *scm.Response, error) {
+	s.created++
+	expiresIn := s.expiresIn
+	if expiresIn == 0 {
+		expiresIn = time.Hour
+	}
+	expires := time.Now().Add(expiresIn)
+	return &scm.InstallationToken{
+		Token:     "v1.installation" + strings.Repeat("1", s.created),
+		ExpiresAt: &expires,
+	}, nil, nil
+}
+
+func (s *mockAppService) GetRepositoryInstallation(ctx context.Context, fullName string) (*scm.Installation, *scm.Response, error) {
+	s.lookups++
+	return &scm.Installation{ID: s.installationID}, nil, nil
+}
+
+func (s *mockAppService) GetOrganisationInstallation(ctx context.Context, organisation string) (*scm.Installation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+func (s *mockAppService) GetUserInstallation(ctx context.Context, user string) (*scm.Installation, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// blockingAppService blocks the creation of the token of an
+// installation until it is released.
+type blockingAppService struct {
+	mockAppService
+	blocked int64
+	release chan struct{}
+}
+
+func (s *blockingAppService) CreateInstallationToken(ctx context.Context, id int64) (*scm.InstallationToken, *scm.Response, error) {
+	if id == s.blocked {
+		<-s.release
+	}
+	return s.mockAppService.CreateInstallationToken(ctx, id)
+}
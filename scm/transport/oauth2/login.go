@@ -0,0 +1,329 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jenkins-x/go-scm/scm"
+)
+
+// defaultPollInterval is the device flow polling interval used
+// when the provider does not specify one.
+var defaultPollInterval = 5 * time.Second
+
+// ErrDeviceCodeExpired is returned when the user does not
+// authorize the device before the device code expires.
+var ErrDeviceCodeExpired = errors.New("device code expired before it was authorized")
+
+// Endpoint contains the OAuth2 endpoints of a git provider.
+type Endpoint struct {
+	AuthURL       string
+	TokenURL      string
+	DeviceAuthURL string // empty if the device flow is not supported
+
+	// BasicAuth sends the client credentials with HTTP basic
+	// authentication rather than in the request body.
+	BasicAuth bool
+}
+
+// EndpointFor returns the OAuth2 endpoints of the provider
+// identified by the driver. The server URL is the web address
+// of a self-hosted server and defaults to the SaaS offering of
+// the provider when empty.
+func EndpointFor(driver scm.Driver, serverURL string) (Endpoint, error) {
+	server := strings.TrimSuffix(serverURL, "/")
+	switch driver {
+	case scm.DriverGithub:
+		if server == "" || server == "https://api.github.com" {
+			server = "https://github.com"
+		}
+		return Endpoint{
+			AuthURL:       server + "/login/oauth/authorize",
+			TokenURL:      server + "/login/oauth/access_token",
+			DeviceAuthURL: server + "/login/device/code",
+		}, nil
+	case scm.DriverGitlab:
+		if server == "" {
+			server = "https://gitlab.com"
+		}
+		return Endpoint{
+			AuthURL:       server + "/oauth/authorize",
+			TokenURL:      server + "/oauth/token",
+			DeviceAuthURL: server + "/oauth/authorize_device",
+		}, nil
+	case scm.DriverGitea:
+		if server == "" {
+			return Endpoint{}, errors.New("no Gitea server URL specified")
+		}
+		return Endpoint{
+			AuthURL:  server + "/login/oauth/authorize",
+			TokenURL: server + "/login/oauth/access_token",
+		}, nil
+	case scm.DriverBitbucket:
+		return Endpoint{
+			AuthURL:   "https://bitbucket.org/site/oauth2/authorize",
+			TokenURL:  "https://bitbucket.org/site/oauth2/access_token",
+			BasicAuth: true,
+		}, nil
+	default:
+		return Endpoint{}, scm.ErrNotSupported
+	}
+}
+
+// Config describes an OAuth2 application registered with a git
+// provider and is used to obtain tokens interactively, either
+// with the authorization code flow or the device flow.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	Endpoint     Endpoint
+
+	Client *http.Client
+}
+
+// DeviceCode is the response of the device authorization
+// request. The user must visit the verification URI and enter
+// the user code to authorize the device.
+type DeviceCode struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	Expires                 time.Time
+	Interval                time.Duration
+}
+
+// GenerateVerifier returns a random PKCE code verifier.
+func GenerateVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthCodeURL returns the URL of the consent page that asks the
+// user to authorize the application. The state protects against
+// CSRF and the verifier, when not empty, is sent as a PKCE S256
+// code challenge.
+func (c *Config) AuthCodeURL(state, verifier string) string {
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", c.ClientID)
+	if c.RedirectURL != "" {
+		values.Set("redirect_uri", c.RedirectURL)
+	}
+	if len(c.Scopes) > 0 {
+		values.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	if state != "" {
+		values.Set("state", state)
+	}
+	if verifier != "" {
+		sum := sha256.Sum256([]byte(verifier))
+		values.Set("code_challenge", base64.RawURLEncoding.EncodeToString(sum[:]))
+		values.Set("code_challenge_method", "S256")
+	}
+	sep := "?"
+	if strings.Contains(c.Endpoint.AuthURL, "?") {
+		sep = "&"
+	}
+	return c.Endpoint.AuthURL + sep + values.Encode()
+}
+
+// Exchange exchanges the authorization code for a token. The
+// verifier must match the one used to build the consent URL.
+func (c *Config) Exchange(ctx context.Context, code, verifier string) (*scm.Token, error) {
+	values := url.Values{}
+	values.Set("grant_type", "authorization_code")
+	values.Set("code", code)
+	if c.RedirectURL != "" {
+		values.Set("redirect_uri", c.RedirectURL)
+	}
+	if verifier != "" {
+		values.Set("code_verifier", verifier)
+	}
+	out, err := c.post(ctx, c.Endpoint.TokenURL, values)
+	if err != nil {
+		return nil, err
+	}
+	if out.Code != "" {
+		return nil, &out.tokenError
+	}
+	return out.token(), nil
+}
+
+// DeviceAuth starts the device authorization grant and returns
+// the codes the user needs to authorize the device.
+func (c *Config) DeviceAuth(ctx context.Context) (*DeviceCode, error) {
+	if c.Endpoint.DeviceAuthURL == "" {
+		return nil, scm.ErrNotSupported
+	}
+	values := url.Values{}
+	if len(c.Scopes) > 0 {
+		values.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	res, err := c.do(ctx, c.Endpoint.DeviceAuthURL, values)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	out := new(deviceGrant)
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return nil, err
+	}
+	if res.StatusCode > 299 || out.Code != "" {
+		return nil, &out.tokenError
+	}
+	interval := time.Duration(out.Interval) * time.Second
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+	return &DeviceCode{
+		DeviceCode:              out.DeviceCode,
+		UserCode:                out.UserCode,
+		VerificationURI:         out.verificationURI(),
+		VerificationURIComplete: out.VerificationURIComplete,
+		Expires:                 time.Now().Add(time.Duration(out.Expires) * time.Second),
+		Interval:                interval,
+	}, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user has
+// authorized the device, the device code expires, the user
+// denies access or the context is cancelled.
+func (c *Config) PollDeviceToken(ctx context.Context, code *DeviceCode) (*scm.Token, error) {
+	interval := code.Interval
+	if interval == 0 {
+		interval = defaultPollInterval
+	}
+	values := url.Values{}
+	values.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+	values.Set("device_code", code.DeviceCode)
+	for {
+		if !code.Expires.IsZero() && time.Now().After(code.Expires) {
+			return nil, ErrDeviceCodeExpired
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		out, err := c.post(ctx, c.Endpoint.TokenURL, values)
+		if err != nil {
+			return nil, err
+		}
+		switch out.Code {
+		case "":
+			return out.token(), nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		default:
+			return nil, &out.tokenError
+		}
+	}
+}
+
+// post sends the form to the token endpoint and decodes the
+// token or error in the response.
+func (c *Config) post(ctx context.Context, endpoint string, values url.Values) (*tokenResponse, error) {
+	res, err := c.do(ctx, endpoint, values)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	out := new(tokenResponse)
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("failed to decode token response with status %d: %v", res.StatusCode, err)
+	}
+	if res.StatusCode > 299 && out.Code == "" {
+		out.Code = http.StatusText(res.StatusCode)
+		out.Message = out.Code
+	}
+	return out, nil
+}
+
+func (c *Config) do(ctx context.Context, endpoint string, values url.Values) (*http.Response, error) {
+	values.Set("client_id", c.ClientID)
+	if c.ClientSecret != "" && !c.Endpoint.BasicAuth {
+		values.Set("client_secret", c.ClientSecret)
+	}
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.Endpoint.BasicAuth {
+		req.SetBasicAuth(c.ClientID, c.ClientSecret)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub responds with form values unless json is requested.
+	req.Header.Set("Accept", "application/json")
+	return c.client().Do(req)
+}
+
+// client returns the http client. If no client is configured,
+// the default client is returned.
+func (c *Config) client() *http.Client {
+	if c.Client != nil {
+		return c.Client
+	}
+	return http.DefaultClient
+}
+
+// tokenResponse is the response of the token endpoint, which
+// may contain either a token or an error.
+type tokenResponse struct {
+	tokenGrant
+	tokenError
+}
+
+func (r *tokenResponse) token() *scm.Token {
+	token := &scm.Token{
+		Token:   r.Access,
+		Refresh: r.Refresh,
+	}
+	if r.Expires > 0 {
+		token.Expires = time.Now().Add(time.Duration(r.Expires) * time.Second)
+	}
+	return token
+}
+
+// deviceGrant is the response of the device authorization
+// endpoint.
+type deviceGrant struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURL         string `json:"verification_url"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	Expires                 int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+	tokenError
+}
+
+// verificationURI returns the verification address, falling
+// back to the non-standard name used by some providers.
+func (g *deviceGrant) verificationURI() string {
+	if g.VerificationURI != "" {
+		return g.VerificationURI
+	}
+	return g.VerificationURL
+}
@@ -9,6 +9,17 @@ import (
 	"time"
 )
 
+// TokenType is the kind of token used to authenticate.
+type TokenType string
+
+// TokenType values.
+const (
+	TokenTypeUnknown      TokenType = ""
+	TokenTypeOAuth        TokenType = "oauth"
+	TokenTypePersonal     TokenType = "personal"
+	TokenTypeInstallation TokenType = "installation"
+)
+
 type (
 	// User represents a user account.
 	User struct {
@@ -29,6 +40,15 @@ type (
 		Token string
 	}
 
+	// TokenInfo describes the token used to authenticate
+	// the client.
+	TokenInfo struct {
+		User    *User
+		Scopes  []string  // nil if the provider does not report scopes
+		Expires time.Time // zero if the token does not expire or it is unknown
+		Type    TokenType
+	}
+
 	// Invitation represents a repo invitation
 	Invitation struct {
 		ID          int64
@@ -62,5 +82,9 @@ type (
 
 		// AcceptInvitation accepts an invitation for the current user
 		AcceptInvitation(context.Context, int64) (*Response, error)
+
+		// FindToken returns the identity, scopes and expiry
+		// of the token used to authenticate the client.
+		FindToken(context.Context) (*TokenInfo, *Response, error)
 	}
 )
@@ -21,6 +21,17 @@ func Split(s string) (owner, name string) {
 	return
 }
 
+// SplitHead splits the head of a pull request into the owner
+// of the fork and the branch name. The owner is empty if the
+// head is a branch of the base repository.
+func SplitHead(head string) (owner, branch string) {
+	parts := strings.SplitN(head, ":", 2)
+	if len(parts) == 1 {
+		return "", parts[0]
+	}
+	return parts[0], parts[1]
+}
+
 // Join joins the repository owner and name segments to
 // create a fully qualified repository name.
 func Join(owner, name string) string {
@@ -30,6 +30,25 @@ func TestSplit(t *testing.T) {
 	}
 }
 
+func TestSplitHead(t *testing.T) {
+	tests := []struct {
+		value, owner, branch string
+	}{
+		{"octocat:feature", "octocat", "feature"},
+		{"octocat:feature/x", "octocat", "feature/x"},
+		{"feature", "", "feature"},
+	}
+	for _, test := range tests {
+		owner, branch := SplitHead(test.value)
+		if got, want := owner, test.owner; got != want {
+			t.Errorf("Got head owner %s, want %s", got, want)
+		}
+		if got, want := branch, test.branch; got != want {
+			t.Errorf("Got head branch %s, want %s", got, want)
+		}
+	}
+}
+
 func TestJoin(t *testing.T) {
 	got, want := Join("octocat", "hello-world"), "octocat/hello-world"
 	if got != want {
@@ -37,6 +37,8 @@ const (
 	WebhookKindIssueComment WebhookKind = "issue_comment"
 	// WebhookKindLabel is for label events
 	WebhookKindLabel WebhookKind = "label"
+	// WebhookKindMergeGroup is for merge group events
+	WebhookKindMergeGroup WebhookKind = "merge_group"
 	// WebhookKindPing is for ping events
 	WebhookKindPing WebhookKind = "ping"
 	// WebhookKindPullRequest is for pull request events
@@ -173,6 +175,28 @@ type (
 		Installation     *InstallationRef
 	}
 
+	// MergeGroup represents the temporary branch created by
+	// a merge queue to test the queued pull requests.
+	MergeGroup struct {
+		HeadSha    string
+		HeadRef    string
+		BaseSha    string
+		BaseRef    string
+		HeadCommit Commit
+	}
+
+	// MergeGroupHook represents a merge group event.
+	// This is currently a GitHub-specific event type.
+	MergeGroupHook struct {
+		Action       Action
+		MergeGroup   MergeGroup
+		Reason       string // why the group was destroyed, eg merged or dequeued
+		Repo         Repository
+		Sender       User
+		GUID         string
+		Installation *InstallationRef
+	}
+
 	// ForkHook represents a fork event
 	ForkHook struct {
 		Repo         Repository
@@ -452,6 +476,9 @@ func (h *WatchHook) Kind() WebhookKind { return WebhookKindWatch }
 // Kind returns the kind of webhook
 func (h *StarHook) Kind() WebhookKind { return WebhookKindStar }
 
+// Kind returns the kind of webhook
+func (h *MergeGroupHook) Kind() WebhookKind { return WebhookKindMergeGroup }
+
 // Repository defines the repository webhook and provides a convenient way to get the associated repository without
 // having to cast the type.
 func (h *PingHook) Repository() Repository { return h.Repo }
@@ -536,6 +563,10 @@ func (h *WatchHook) Repository() Repository { return h.Repo }
 // having to cast the type.
 func (h *StarHook) Repository() Repository { return h.Repo }
 
+// Repository defines the repository webhook and provides a convenient way to get the associated repository without
+// having to cast the type.
+func (h *MergeGroupHook) Repository() Repository { return h.Repo }
+
 // Repository defines the repository webhook and provides a convenient way to get the associated repository without
 // having to cast the type.
 func (h *InstallationHook) Repository() Repository {
@@ -638,6 +669,10 @@ func (h *WatchHook) GetInstallationRef() *InstallationRef { return h.Installatio
 // GitHub App
 func (h *StarHook) GetInstallationRef() *InstallationRef { return nil }
 
+// GetInstallationRef returns the installation reference if the webhook is invoked on a
+// GitHub App
+func (h *MergeGroupHook) GetInstallationRef() *InstallationRef { return h.Installation }
+
 // GetInstallationRef returns the installation reference if the webhook is invoked on a
 // GitHub App
 func (h *InstallationHook) GetInstallationRef() *InstallationRef {